@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/authorization/mgmt/2018-09-01-preview/authorization"
@@ -13,6 +14,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/authorization/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/authorization/validate"
 	azSchema "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
@@ -80,21 +82,24 @@ func resourceArmRoleDefinition() *schema.Resource {
 							Type:     schema.TypeList,
 							Optional: true,
 							Elem: &schema.Schema{
-								Type: schema.TypeString,
+								Type:         schema.TypeString,
+								ValidateFunc: validate.RoleDefinitionAction,
 							},
 						},
 						"not_actions": {
 							Type:     schema.TypeList,
 							Optional: true,
 							Elem: &schema.Schema{
-								Type: schema.TypeString,
+								Type:         schema.TypeString,
+								ValidateFunc: validate.RoleDefinitionAction,
 							},
 						},
 						"data_actions": {
 							Type:     schema.TypeSet,
 							Optional: true,
 							Elem: &schema.Schema{
-								Type: schema.TypeString,
+								Type:         schema.TypeString,
+								ValidateFunc: validate.RoleDefinitionAction,
 							},
 							Set: schema.HashString,
 						},
@@ -102,7 +107,8 @@ func resourceArmRoleDefinition() *schema.Resource {
 							Type:     schema.TypeSet,
 							Optional: true,
 							Elem: &schema.Schema{
-								Type: schema.TypeString,
+								Type:         schema.TypeString,
+								ValidateFunc: validate.RoleDefinitionAction,
 							},
 							Set: schema.HashString,
 						},
@@ -111,7 +117,7 @@ func resourceArmRoleDefinition() *schema.Resource {
 			},
 
 			"assignable_scopes": {
-				Type:     schema.TypeList,
+				Type:     schema.TypeSet,
 				Optional: true,
 				Computed: true,
 				Elem: &schema.Schema{
@@ -147,6 +153,9 @@ func resourceArmRoleDefinitionCreateUpdate(d *schema.ResourceData, meta interfac
 	description := d.Get("description").(string)
 	roleType := "CustomRole"
 	permissions := expandRoleDefinitionPermissions(d)
+	if err := validateRoleDefinitionPermissions(permissions); err != nil {
+		return err
+	}
 	assignableScopes := expandRoleDefinitionAssignableScopes(d)
 
 	if d.IsNewResource() {
@@ -333,10 +342,30 @@ func expandRoleDefinitionPermissions(d *schema.ResourceData) []authorization.Per
 	return output
 }
 
+// validateRoleDefinitionPermissions checks that Data Actions haven't been mixed up with the (management) Not Actions,
+// since Azure evaluates these separately - so a Data Action listed in `not_actions` has no effect
+func validateRoleDefinitionPermissions(permissions []authorization.Permission) error {
+	for _, permission := range permissions {
+		if permission.DataActions == nil || permission.NotActions == nil {
+			continue
+		}
+
+		for _, dataAction := range *permission.DataActions {
+			for _, notAction := range *permission.NotActions {
+				if strings.EqualFold(dataAction, notAction) {
+					return fmt.Errorf("%q is specified in both `data_actions` and `not_actions` - Data Actions should be excluded using `not_data_actions`", dataAction)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
 func expandRoleDefinitionAssignableScopes(d *schema.ResourceData) []string {
 	scopes := make([]string, 0)
 
-	assignableScopes := d.Get("assignable_scopes").([]interface{})
+	assignableScopes := d.Get("assignable_scopes").(*schema.Set).List()
 	if len(assignableScopes) == 0 {
 		assignedScope := d.Get("scope").(string)
 		scopes = append(scopes, assignedScope)
@@ -156,6 +156,28 @@ func TestAccAzureRMRoleDefinition_assignToSmallerScope(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMRoleDefinition_assignableScopesOrdering(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_role_definition", "test")
+	r := RoleDefinitionResource{}
+	id := uuid.New().String()
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.multipleAssignableScopes(id, data, false),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("assignable_scopes.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+		{
+			// the same scopes in the reverse order shouldn't produce a diff
+			Config:   r.multipleAssignableScopes(id, data, true),
+			PlanOnly: true,
+		},
+	})
+}
+
 func TestAccAzureRMRoleDefinition_noAssignableScope(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_role_definition", "test")
 
@@ -402,6 +424,46 @@ resource "azurerm_role_definition" "test" {
 `, data.RandomInteger, data.Locations.Primary, id, data.RandomInteger)
 }
 
+func (RoleDefinitionResource) multipleAssignableScopes(id string, data acceptance.TestData, reversed bool) string {
+	scopes := []string{
+		"data.azurerm_subscription.primary.id",
+		"azurerm_resource_group.test.id",
+	}
+	if reversed {
+		scopes[0], scopes[1] = scopes[1], scopes[0]
+	}
+
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_subscription" "primary" {
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestrg-%d"
+  location = "%s"
+}
+
+resource "azurerm_role_definition" "test" {
+  role_definition_id = "%s"
+  name               = "acctestrd-%d"
+  scope              = data.azurerm_subscription.primary.id
+
+  permissions {
+    actions     = ["*"]
+    not_actions = []
+  }
+
+  assignable_scopes = [
+    %s,
+    %s,
+  ]
+}
+`, data.RandomInteger, data.Locations.Primary, id, data.RandomInteger, scopes[0], scopes[1])
+}
+
 func (RoleDefinitionResource) noAssignableScope(id string, data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
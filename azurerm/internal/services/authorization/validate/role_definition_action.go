@@ -0,0 +1,27 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// RoleDefinitionAction validates that an action (or data action) is either a wildcard or in the format
+// `{Company}.{ProviderName}/{resourceType}/{action}`, where any segment after the provider can be a wildcard
+func RoleDefinitionAction(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if v == "" {
+		errors = append(errors, fmt.Errorf("%q must not be empty", k))
+		return
+	}
+
+	if !regexp.MustCompile(`^(\*|[a-zA-Z0-9][a-zA-Z0-9-]*(\.[a-zA-Z0-9][a-zA-Z0-9-]*)+)(/[^/\s]+)*$`).MatchString(v) {
+		errors = append(errors, fmt.Errorf("%q must be `*` or in the format `{Company}.{ProviderName}/{resourceType}/{action}` (e.g. `Microsoft.Storage/storageAccounts/read`), got %q", k, v))
+	}
+
+	return
+}
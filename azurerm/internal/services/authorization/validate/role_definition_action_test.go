@@ -0,0 +1,80 @@
+package validate
+
+import "testing"
+
+func TestRoleDefinitionAction(t *testing.T) {
+	cases := []struct {
+		Value string
+		Valid bool
+	}{
+		{
+			Value: "",
+			Valid: false,
+		},
+		{
+			Value: "*",
+			Valid: true,
+		},
+		{
+			Value: "*/read",
+			Valid: true,
+		},
+		{
+			Value: "Microsoft.Compute/*",
+			Valid: true,
+		},
+		{
+			Value: "Microsoft.Authorization/*/read",
+			Valid: true,
+		},
+		{
+			Value: "Microsoft.Storage/storageAccounts/read",
+			Valid: true,
+		},
+		{
+			Value: "Microsoft.Storage/storageAccounts/blobServices/containers/blobs/read",
+			Valid: true,
+		},
+		{
+			Value: "Microsoft.Insights/alertRules/*",
+			Valid: true,
+		},
+		{
+			// missing the provider namespace
+			Value: "Microsoft/storageAccounts/read",
+			Valid: false,
+		},
+		{
+			Value: "storageAccounts/read",
+			Valid: false,
+		},
+		{
+			Value: "/Microsoft.Storage/storageAccounts/read",
+			Valid: false,
+		},
+		{
+			Value: "Microsoft.Storage/storageAccounts/read/",
+			Valid: false,
+		},
+		{
+			Value: "Microsoft.Storage//read",
+			Valid: false,
+		},
+		{
+			Value: "Microsoft.Storage/storage Accounts/read",
+			Valid: false,
+		},
+		{
+			Value: "Microsoft..Storage/storageAccounts/read",
+			Valid: false,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := RoleDefinitionAction(tc.Value, "actions")
+		valid := len(errors) == 0
+		if valid != tc.Valid {
+			t.Fatalf("Expected RoleDefinitionAction to return %t for %q - got %t", tc.Valid, tc.Value, valid)
+		}
+	}
+}
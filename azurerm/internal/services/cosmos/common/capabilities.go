@@ -0,0 +1,60 @@
+package common
+
+import (
+	"fmt"
+	"strings"
+)
+
+// these capabilities can be enabled on an existing Cosmos DB Account without it being re-created
+var capabilitiesAddableInPlace = []string{
+	"AllowSelfServeUpgradeToMongo36",
+	"DisableRateLimitingResponses",
+	"EnableAggregationPipeline",
+	"EnableAnalyticalStorage",
+	"MongoDBv3.4",
+	"mongoEnableDocLevelTTL",
+}
+
+// these capabilities can't be disabled once they've been enabled on a Cosmos DB Account
+var capabilitiesNonRemovable = []string{
+	"EnableServerless",
+}
+
+// CosmosDBAccountCapabilitiesChange determines whether the capabilities of an existing Cosmos DB Account
+// can be changed from `old` to `new` in-place, or whether the account needs to be re-created - returning
+// an error if a capability which can't be disabled is being removed
+func CosmosDBAccountCapabilitiesChange(old []string, new []string) (forceNew bool, err error) {
+	for _, capability := range old {
+		if containsCapability(new, capability) {
+			continue
+		}
+
+		if containsCapability(capabilitiesNonRemovable, capability) {
+			return false, fmt.Errorf("the capability %q cannot be removed from an existing Cosmos DB Account", capability)
+		}
+
+		forceNew = true
+	}
+
+	for _, capability := range new {
+		if containsCapability(old, capability) {
+			continue
+		}
+
+		if !containsCapability(capabilitiesAddableInPlace, capability) {
+			forceNew = true
+		}
+	}
+
+	return forceNew, nil
+}
+
+func containsCapability(capabilities []string, capability string) bool {
+	for _, v := range capabilities {
+		if strings.EqualFold(v, capability) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,85 @@
+package common
+
+import (
+	"testing"
+)
+
+func TestCosmosDBAccountCapabilitiesChange(t *testing.T) {
+	testData := []struct {
+		Name             string
+		Old              []string
+		New              []string
+		ExpectedForceNew bool
+		ExpectError      bool
+	}{
+		{
+			Name:             "unchanged",
+			Old:              []string{"EnableCassandra"},
+			New:              []string{"EnableCassandra"},
+			ExpectedForceNew: false,
+		},
+		{
+			Name:             "casing difference",
+			Old:              []string{"EnableAggregationPipeline"},
+			New:              []string{"enableaggregationpipeline"},
+			ExpectedForceNew: false,
+		},
+		{
+			Name:             "add EnableAggregationPipeline",
+			Old:              []string{"EnableCassandra"},
+			New:              []string{"EnableCassandra", "EnableAggregationPipeline"},
+			ExpectedForceNew: false,
+		},
+		{
+			Name:             "add EnableCassandra",
+			Old:              []string{},
+			New:              []string{"EnableCassandra"},
+			ExpectedForceNew: true,
+		},
+		{
+			Name:             "remove EnableAggregationPipeline",
+			Old:              []string{"EnableAggregationPipeline"},
+			New:              []string{},
+			ExpectedForceNew: true,
+		},
+		{
+			Name:             "replace EnableCassandra",
+			Old:              []string{"EnableCassandra"},
+			New:              []string{"EnableTable", "EnableAggregationPipeline"},
+			ExpectedForceNew: true,
+		},
+		{
+			Name:        "remove EnableServerless",
+			Old:         []string{"EnableServerless"},
+			New:         []string{},
+			ExpectError: true,
+		},
+		{
+			Name:        "remove EnableServerless whilst adding EnableAggregationPipeline",
+			Old:         []string{"EnableServerless"},
+			New:         []string{"EnableAggregationPipeline"},
+			ExpectError: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		forceNew, err := CosmosDBAccountCapabilitiesChange(v.Old, v.New)
+		if err != nil {
+			if v.ExpectError {
+				continue
+			}
+
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+
+		if v.ExpectError {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+
+		if forceNew != v.ExpectedForceNew {
+			t.Fatalf("Expected forceNew to be %t but got %t", v.ExpectedForceNew, forceNew)
+		}
+	}
+}
@@ -64,3 +64,23 @@ func CheckForChangeFromAutoscaleAndManualThroughput(d *schema.ResourceData) erro
 func HasThroughputChange(d *schema.ResourceData) bool {
 	return d.HasChanges("throughput", "autoscale_settings")
 }
+
+// HasChangeFromManualToAutoscaleThroughput returns whether the throughput is being switched from manually provisioned to autoscale
+func HasChangeFromManualToAutoscaleThroughput(d *schema.ResourceData) bool {
+	if !d.HasChange("autoscale_settings") {
+		return false
+	}
+
+	old, new := d.GetChange("autoscale_settings")
+	return len(old.([]interface{})) == 0 && len(new.([]interface{})) > 0
+}
+
+// HasChangeFromAutoscaleToManualThroughput returns whether the throughput is being switched from autoscale to manually provisioned
+func HasChangeFromAutoscaleToManualThroughput(d *schema.ResourceData) bool {
+	if !d.HasChange("autoscale_settings") {
+		return false
+	}
+
+	old, new := d.GetChange("autoscale_settings")
+	return len(old.([]interface{})) > 0 && len(new.([]interface{})) == 0
+}
@@ -7,6 +7,7 @@ import (
 	"log"
 	"net/http"
 	"regexp"
+	"sort"
 	"strings"
 	"time"
 
@@ -20,6 +21,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/location"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/cosmos/common"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/cosmos/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/cosmos/validate"
 	keyVaultParse "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/keyvault/parse"
 	keyVaultValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/keyvault/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
@@ -58,6 +60,8 @@ func resourceCosmosDbAccount() *schema.Resource {
 			Delete: schema.DefaultTimeout(180 * time.Minute),
 		},
 
+		CustomizeDiff: resourceCosmosDbAccountCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -217,7 +221,6 @@ func resourceCosmosDbAccount() *schema.Resource {
 			"capabilities": {
 				Type:     schema.TypeSet,
 				Optional: true,
-				ForceNew: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"name": {
@@ -364,6 +367,48 @@ func resourceCosmosDbAccount() *schema.Resource {
 	}
 }
 
+func resourceCosmosDbAccountCustomizeDiff(d *schema.ResourceDiff, _ interface{}) error {
+	if d.NewValueKnown("geo_location") {
+		for _, v := range d.Get("geo_location").(*schema.Set).List() {
+			geoLocation := v.(map[string]interface{})
+			if !geoLocation["zone_redundant"].(bool) {
+				continue
+			}
+
+			if err := validate.CosmosDBAccountLocationZoneSupport(geoLocation["location"].(string)); err != nil {
+				return fmt.Errorf("`zone_redundant` cannot be enabled for the `geo_location` %q: %+v", geoLocation["location"].(string), err)
+			}
+		}
+	}
+
+	// some capabilities can be enabled in-place, others require the account to be re-created or can't be removed at all
+	if d.Id() == "" || !d.HasChange("capabilities") {
+		return nil
+	}
+
+	old, new := d.GetChange("capabilities")
+	forceNew, err := common.CosmosDBAccountCapabilitiesChange(flattenCosmosDbAccountCapabilityNames(old.(*schema.Set)), flattenCosmosDbAccountCapabilityNames(new.(*schema.Set)))
+	if err != nil {
+		return fmt.Errorf("updating `capabilities`: %+v", err)
+	}
+
+	if forceNew {
+		return d.ForceNew("capabilities")
+	}
+
+	return nil
+}
+
+func flattenCosmosDbAccountCapabilityNames(input *schema.Set) []string {
+	names := make([]string, 0)
+	for _, v := range input.List() {
+		m := v.(map[string]interface{})
+		names = append(names, m["name"].(string))
+	}
+
+	return names
+}
+
 func resourceCosmosDbAccountCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Cosmos.DatabaseClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
@@ -519,6 +564,20 @@ func resourceCosmosDbAccountUpdate(d *schema.ResourceData, meta interface{}) err
 		oldLocationsMap[azure.NormalizeLocation(*location.LocationName)] = location
 	}
 
+	// reordering the failover priorities of the other locations is done in-place, however changing the write location
+	// would trigger a manual failover of the account - which shouldn't happen as a side effect of a routine apply
+	for _, l := range newLocations {
+		if *l.FailoverPriority != 0 {
+			continue
+		}
+
+		for _, ol := range oldLocations {
+			if *ol.FailoverPriority == 0 && azure.NormalizeLocation(*ol.LocationName) != azure.NormalizeLocation(*l.LocationName) {
+				return fmt.Errorf("cannot move the write location of Cosmos DB Account %q (Resource Group %q) from %s to %s - this requires a manual failover, which should be performed outside of Terraform", name, resourceGroup, *ol.LocationName, *l.LocationName)
+			}
+		}
+	}
+
 	publicNetworkAccess := documentdb.Enabled
 	if enabled := d.Get("public_network_access_enabled").(bool); !enabled {
 		publicNetworkAccess = documentdb.Disabled
@@ -566,34 +625,59 @@ func resourceCosmosDbAccountUpdate(d *schema.ResourceData, meta interface{}) err
 		}
 	}
 
-	// determine if any locations have been renamed/priority reordered and remove them
-	removedOne := false
+	// add any new locations after the existing ones, so that the failover priorities can then be updated in-place
+	addedLocations := make([]documentdb.Location, 0)
 	for _, l := range newLocations {
-		if ol, ok := oldLocationsMap[*l.LocationName]; ok {
-			if *l.FailoverPriority != *ol.FailoverPriority {
-				if *l.FailoverPriority == 0 {
-					return fmt.Errorf("Cannot change the failover priority of primary Cosmos DB account %q location %s to %d (Resource Group %q)", name, *l.LocationName, *l.FailoverPriority, resourceGroup)
-				}
-				delete(oldLocationsMap, *l.LocationName)
-				removedOne = true
-				continue
-			}
+		if _, ok := oldLocationsMap[*l.LocationName]; !ok {
+			addedLocations = append(addedLocations, l)
 		}
 	}
 
-	if removedOne {
-		locationsUnchanged := make([]documentdb.Location, 0, len(oldLocationsMap))
-		for _, value := range oldLocationsMap {
-			locationsUnchanged = append(locationsUnchanged, value)
+	currentLocations := oldLocations
+	if len(addedLocations) > 0 {
+		sort.Slice(addedLocations, func(i, j int) bool {
+			return *addedLocations[i].FailoverPriority < *addedLocations[j].FailoverPriority
+		})
+
+		nextPriority := int32(0)
+		for _, l := range oldLocations {
+			if *l.FailoverPriority >= nextPriority {
+				nextPriority = *l.FailoverPriority + 1
+			}
+		}
+
+		currentLocations = make([]documentdb.Location, 0, len(oldLocations)+len(addedLocations))
+		currentLocations = append(currentLocations, oldLocations...)
+		for _, l := range addedLocations {
+			currentLocations = append(currentLocations, documentdb.Location{
+				LocationName:     l.LocationName,
+				FailoverPriority: utils.Int32(nextPriority),
+				IsZoneRedundant:  l.IsZoneRedundant,
+			})
+			nextPriority++
 		}
 
-		account.DatabaseAccountCreateUpdateProperties.Locations = &locationsUnchanged
+		account.DatabaseAccountCreateUpdateProperties.Locations = &currentLocations
 		if _, err = resourceCosmosDbAccountApiUpsert(client, ctx, resourceGroup, name, account, d); err != nil {
-			return fmt.Errorf("Error removing CosmosDB Account %q renamed locations (Resource Group %q): %+v", name, resourceGroup, err)
+			return fmt.Errorf("Error adding CosmosDB Account %q locations (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	}
+
+	// then reorder the failover priorities of the existing locations, rather than removing and re-adding them
+	if failoverPolicies, changed := expandAzureRmCosmosDBAccountFailoverPolicies(currentLocations, newLocations); changed {
+		future, err := client.FailoverPriorityChange(ctx, resourceGroup, name, documentdb.FailoverPolicies{
+			FailoverPolicies: failoverPolicies,
+		})
+		if err != nil {
+			return fmt.Errorf("Error updating CosmosDB Account %q failover priorities (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+
+		if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("Error waiting for the CosmosDB Account %q (Resource Group %q) to finish updating failover priorities: %+v", name, resourceGroup, err)
 		}
 	}
 
-	// add any new/renamed locations
+	// finally remove any locations which are no longer required
 	account.DatabaseAccountCreateUpdateProperties.Locations = &newLocations
 	upsertResponse, err := resourceCosmosDbAccountApiUpsert(client, ctx, resourceGroup, name, account, d)
 	if err != nil {
@@ -928,6 +1012,44 @@ func expandAzureRmCosmosDBAccountGeoLocations(d *schema.ResourceData) ([]documen
 	return locations, nil
 }
 
+// expandAzureRmCosmosDBAccountFailoverPolicies orders the current locations by their desired failover priority,
+// with any locations which are being removed moved to the end, and returns whether this differs from the current order
+func expandAzureRmCosmosDBAccountFailoverPolicies(current []documentdb.Location, desired []documentdb.Location) (*[]documentdb.FailoverPolicy, bool) {
+	desiredPriorities := make(map[string]int32, len(desired))
+	for _, l := range desired {
+		desiredPriorities[azure.NormalizeLocation(*l.LocationName)] = *l.FailoverPriority
+	}
+
+	ordered := make([]documentdb.Location, len(current))
+	copy(ordered, current)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		iPriority, iDesired := desiredPriorities[azure.NormalizeLocation(*ordered[i].LocationName)]
+		jPriority, jDesired := desiredPriorities[azure.NormalizeLocation(*ordered[j].LocationName)]
+		if iDesired != jDesired {
+			return iDesired
+		}
+		if !iDesired {
+			return *ordered[i].FailoverPriority < *ordered[j].FailoverPriority
+		}
+		return iPriority < jPriority
+	})
+
+	changed := false
+	policies := make([]documentdb.FailoverPolicy, 0, len(ordered))
+	for i, l := range ordered {
+		if *l.FailoverPriority != int32(i) {
+			changed = true
+		}
+
+		policies = append(policies, documentdb.FailoverPolicy{
+			LocationName:     l.LocationName,
+			FailoverPriority: utils.Int32(int32(i)),
+		})
+	}
+
+	return &policies, changed
+}
+
 func expandAzureRmCosmosDBAccountCapabilities(d *schema.ResourceData) *[]documentdb.Capability {
 	capabilities := d.Get("capabilities").(*schema.Set).List()
 	s := make([]documentdb.Capability, 0)
@@ -1,6 +1,7 @@
 package cosmos
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -47,6 +48,8 @@ func resourceCosmosDbSQLContainer() *schema.Resource {
 			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
+		CustomizeDiff: resourceCosmosDbSQLContainerCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -101,6 +104,13 @@ func resourceCosmosDbSQLContainer() *schema.Resource {
 				ValidateFunc: validation.IntAtLeast(-1),
 			},
 
+			"analytical_storage_ttl": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(-1),
+			},
+
 			"unique_key": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -120,12 +130,48 @@ func resourceCosmosDbSQLContainer() *schema.Resource {
 				},
 			},
 			"indexing_policy": common.CosmosDbIndexingPolicySchema(),
+
+			"conflict_resolution_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"mode": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(documentdb.LastWriterWins),
+								string(documentdb.Custom),
+							}, false),
+						},
+
+						"conflict_resolution_path": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"conflict_resolution_procedure": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
 func resourceCosmosDbSQLContainerCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Cosmos.SqlClient
+	accountClient := meta.(*clients.Client).Cosmos.DatabaseClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -157,8 +203,9 @@ func resourceCosmosDbSQLContainerCreate(d *schema.ResourceData, meta interface{}
 	db := documentdb.SQLContainerCreateUpdateParameters{
 		SQLContainerCreateUpdateProperties: &documentdb.SQLContainerCreateUpdateProperties{
 			Resource: &documentdb.SQLContainerResource{
-				ID:             &name,
-				IndexingPolicy: indexingPolicy,
+				ID:                       &name,
+				IndexingPolicy:           indexingPolicy,
+				ConflictResolutionPolicy: expandCosmosSQLContainerConflictResolutionPolicy(d.Get("conflict_resolution_policy").([]interface{})),
 			},
 			Options: &documentdb.CreateUpdateOptions{},
 		},
@@ -185,6 +232,14 @@ func resourceCosmosDbSQLContainerCreate(d *schema.ResourceData, meta interface{}
 		db.SQLContainerCreateUpdateProperties.Resource.DefaultTTL = utils.Int32(int32(defaultTTL.(int)))
 	}
 
+	if analyticalStorageTTL, ok := d.GetOkExists("analytical_storage_ttl"); ok {
+		if err := checkCosmosDbAccountAnalyticalStorageEnabled(ctx, accountClient, resourceGroup, account); err != nil {
+			return fmt.Errorf("Error creating Cosmos SQL Container %q (Account: %q, Database: %q): %+v", name, account, database, err)
+		}
+
+		db.SQLContainerCreateUpdateProperties.Resource.AnalyticalStorageTTL = utils.Int64(int64(analyticalStorageTTL.(int)))
+	}
+
 	if throughput, hasThroughput := d.GetOk("throughput"); hasThroughput {
 		if throughput != 0 {
 			db.SQLContainerCreateUpdateProperties.Options.Throughput = common.ConvertThroughputFromResourceData(throughput)
@@ -220,6 +275,7 @@ func resourceCosmosDbSQLContainerCreate(d *schema.ResourceData, meta interface{}
 
 func resourceCosmosDbSQLContainerUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Cosmos.SqlClient
+	accountClient := meta.(*clients.Client).Cosmos.DatabaseClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -244,8 +300,9 @@ func resourceCosmosDbSQLContainerUpdate(d *schema.ResourceData, meta interface{}
 	db := documentdb.SQLContainerCreateUpdateParameters{
 		SQLContainerCreateUpdateProperties: &documentdb.SQLContainerCreateUpdateProperties{
 			Resource: &documentdb.SQLContainerResource{
-				ID:             &id.ContainerName,
-				IndexingPolicy: indexingPolicy,
+				ID:                       &id.ContainerName,
+				IndexingPolicy:           indexingPolicy,
+				ConflictResolutionPolicy: expandCosmosSQLContainerConflictResolutionPolicy(d.Get("conflict_resolution_policy").([]interface{})),
 			},
 			Options: &documentdb.CreateUpdateOptions{},
 		},
@@ -272,6 +329,16 @@ func resourceCosmosDbSQLContainerUpdate(d *schema.ResourceData, meta interface{}
 		db.SQLContainerCreateUpdateProperties.Resource.DefaultTTL = utils.Int32(int32(defaultTTL.(int)))
 	}
 
+	if analyticalStorageTTL, ok := d.GetOkExists("analytical_storage_ttl"); ok {
+		if d.HasChange("analytical_storage_ttl") {
+			if err := checkCosmosDbAccountAnalyticalStorageEnabled(ctx, accountClient, id.ResourceGroup, id.DatabaseAccountName); err != nil {
+				return fmt.Errorf("Error updating Cosmos SQL Container %q (Account: %q, Database: %q): %+v", id.ContainerName, id.DatabaseAccountName, id.SqlDatabaseName, err)
+			}
+		}
+
+		db.SQLContainerCreateUpdateProperties.Resource.AnalyticalStorageTTL = utils.Int64(int64(analyticalStorageTTL.(int)))
+	}
+
 	future, err := client.CreateUpdateSQLContainer(ctx, id.ResourceGroup, id.DatabaseAccountName, id.SqlDatabaseName, id.ContainerName, db)
 	if err != nil {
 		return fmt.Errorf("Error issuing create/update request for Cosmos SQL Container %q (Account: %q, Database: %q): %+v", id.ContainerName, id.DatabaseAccountName, id.SqlDatabaseName, err)
@@ -351,9 +418,17 @@ func resourceCosmosDbSQLContainerRead(d *schema.ResourceData, meta interface{})
 				d.Set("default_ttl", defaultTTL)
 			}
 
+			if analyticalStorageTTL := res.AnalyticalStorageTTL; analyticalStorageTTL != nil {
+				d.Set("analytical_storage_ttl", analyticalStorageTTL)
+			}
+
 			if indexingPolicy := res.IndexingPolicy; indexingPolicy != nil {
 				d.Set("indexing_policy", common.FlattenAzureRmCosmosDbIndexingPolicy(indexingPolicy))
 			}
+
+			if err := d.Set("conflict_resolution_policy", flattenCosmosSQLContainerConflictResolutionPolicy(res.ConflictResolutionPolicy)); err != nil {
+				return fmt.Errorf("Error setting `conflict_resolution_policy`: %+v", err)
+			}
 		}
 	}
 
@@ -409,6 +484,33 @@ func resourceCosmosDbSQLContainerDelete(d *schema.ResourceData, meta interface{}
 	return nil
 }
 
+func resourceCosmosDbSQLContainerCustomizeDiff(d *schema.ResourceDiff, _ interface{}) error {
+	if !d.NewValueKnown("conflict_resolution_policy") {
+		return nil
+	}
+
+	policies := d.Get("conflict_resolution_policy").([]interface{})
+	if len(policies) == 0 || policies[0] == nil {
+		return nil
+	}
+
+	policy := policies[0].(map[string]interface{})
+	return validate.CosmosDbConflictResolutionPolicy(policy["mode"].(string), policy["conflict_resolution_path"].(string), policy["conflict_resolution_procedure"].(string))
+}
+
+func checkCosmosDbAccountAnalyticalStorageEnabled(ctx context.Context, client *documentdb.DatabaseAccountsClient, resourceGroup, account string) error {
+	resp, err := client.Get(ctx, resourceGroup, account)
+	if err != nil {
+		return fmt.Errorf("reading CosmosDB Account %q (Resource Group %q): %+v", account, resourceGroup, err)
+	}
+
+	if props := resp.DatabaseAccountGetProperties; props == nil || props.EnableAnalyticalStorage == nil || !*props.EnableAnalyticalStorage {
+		return fmt.Errorf("`analytical_storage_ttl` can only be set when `analytical_storage_enabled` is enabled on the CosmosDB Account %q (Resource Group %q)", account, resourceGroup)
+	}
+
+	return nil
+}
+
 func expandCosmosSQLContainerUniqueKeys(s *schema.Set) *[]documentdb.UniqueKey {
 	i := s.List()
 	if len(i) == 0 || i[0] == nil {
@@ -450,3 +552,48 @@ func flattenCosmosSQLContainerUniqueKeys(keys *[]documentdb.UniqueKey) *[]map[st
 
 	return &slice
 }
+
+func expandCosmosSQLContainerConflictResolutionPolicy(input []interface{}) *documentdb.ConflictResolutionPolicy {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+	v := input[0].(map[string]interface{})
+
+	policy := &documentdb.ConflictResolutionPolicy{
+		Mode: documentdb.ConflictResolutionMode(v["mode"].(string)),
+	}
+
+	if path := v["conflict_resolution_path"].(string); path != "" {
+		policy.ConflictResolutionPath = utils.String(path)
+	}
+
+	if procedure := v["conflict_resolution_procedure"].(string); procedure != "" {
+		policy.ConflictResolutionProcedure = utils.String(procedure)
+	}
+
+	return policy
+}
+
+func flattenCosmosSQLContainerConflictResolutionPolicy(input *documentdb.ConflictResolutionPolicy) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	path := ""
+	if input.ConflictResolutionPath != nil {
+		path = *input.ConflictResolutionPath
+	}
+
+	procedure := ""
+	if input.ConflictResolutionProcedure != nil {
+		procedure = *input.ConflictResolutionProcedure
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"mode":                          string(input.Mode),
+			"conflict_resolution_path":      path,
+			"conflict_resolution_procedure": procedure,
+		},
+	}
+}
@@ -3,8 +3,10 @@ package cosmos_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
+	"github.com/Azure/azure-sdk-for-go/services/preview/cosmos-db/mgmt/2020-04-01-preview/documentdb"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
@@ -177,6 +179,36 @@ func TestAccCosmosDbSqlContainer_partition_key_version(t *testing.T) {
 	})
 }
 
+func TestAccCosmosDbSqlContainer_conflictResolutionPolicyLastWriterWins(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cosmosdb_sql_container", "test")
+	r := CosmosSqlContainerResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+
+			Config: r.conflictResolutionPolicyLastWriterWins(data),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("conflict_resolution_policy.0.mode").HasValue("LastWriterWins"),
+				check.That(data.ResourceName).Key("conflict_resolution_policy.0.conflict_resolution_path").HasValue("/definition/updatedAt"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccCosmosDbSqlContainer_conflictResolutionPolicyMissingPath(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cosmosdb_sql_container", "test")
+	r := CosmosSqlContainerResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config:      r.conflictResolutionPolicyMissingPath(data),
+			ExpectError: regexp.MustCompile("`conflict_resolution_path` must be set when `mode` is \"LastWriterWins\""),
+		},
+	})
+}
+
 func (t CosmosSqlContainerResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
 	id, err := parse.SqlContainerID(state.ID)
 	if err != nil {
@@ -191,6 +223,35 @@ func (t CosmosSqlContainerResource) Exists(ctx context.Context, clients *clients
 	return utils.Bool(resp.ID != nil), nil
 }
 
+func TestAccCosmosDbSqlContainer_analyticalStorageTTL(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cosmosdb_sql_container", "test")
+	r := CosmosSqlContainerResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.analyticalStorageTTL(data, 600),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("analytical_storage_ttl").HasValue("600"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.analyticalStorageTTL(data, -1),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("analytical_storage_ttl").HasValue("-1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			// the Analytical Storage TTL is retained when the argument is removed
+			Config:   r.analyticalStorageTTLRemoved(data),
+			PlanOnly: true,
+		},
+	})
+}
+
 func (CosmosSqlContainerResource) basic(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %[1]s
@@ -204,6 +265,47 @@ resource "azurerm_cosmosdb_sql_container" "test" {
 `, CosmosSqlDatabaseResource{}.basic(data), data.RandomInteger)
 }
 
+func (CosmosSqlContainerResource) analyticalStorageTTL(data acceptance.TestData, analyticalStorageTTL int) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_cosmosdb_sql_database" "test" {
+  name                = "acctest-%[2]d"
+  resource_group_name = azurerm_cosmosdb_account.test.resource_group_name
+  account_name        = azurerm_cosmosdb_account.test.name
+}
+
+resource "azurerm_cosmosdb_sql_container" "test" {
+  name                   = "acctest-CSQLC-%[2]d"
+  resource_group_name    = azurerm_cosmosdb_account.test.resource_group_name
+  account_name           = azurerm_cosmosdb_account.test.name
+  database_name          = azurerm_cosmosdb_sql_database.test.name
+  partition_key_path     = "/definition/id"
+  analytical_storage_ttl = %[3]d
+}
+`, CosmosDBAccountResource{}.analyticalStorage(data, "GlobalDocumentDB", documentdb.Eventual), data.RandomInteger, analyticalStorageTTL)
+}
+
+func (CosmosSqlContainerResource) analyticalStorageTTLRemoved(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_cosmosdb_sql_database" "test" {
+  name                = "acctest-%[2]d"
+  resource_group_name = azurerm_cosmosdb_account.test.resource_group_name
+  account_name        = azurerm_cosmosdb_account.test.name
+}
+
+resource "azurerm_cosmosdb_sql_container" "test" {
+  name                = "acctest-CSQLC-%[2]d"
+  resource_group_name = azurerm_cosmosdb_account.test.resource_group_name
+  account_name        = azurerm_cosmosdb_account.test.name
+  database_name       = azurerm_cosmosdb_sql_database.test.name
+  partition_key_path  = "/definition/id"
+}
+`, CosmosDBAccountResource{}.analyticalStorage(data, "GlobalDocumentDB", documentdb.Eventual), data.RandomInteger)
+}
+
 func (CosmosSqlContainerResource) basic_serverless(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %[1]s
@@ -408,3 +510,40 @@ resource "azurerm_cosmosdb_sql_container" "test" {
 }
 `, CosmosSqlDatabaseResource{}.basic(data), data.RandomInteger, version)
 }
+
+func (CosmosSqlContainerResource) conflictResolutionPolicyLastWriterWins(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_cosmosdb_sql_container" "test" {
+  name                = "acctest-CSQLC-%[2]d"
+  resource_group_name = azurerm_cosmosdb_account.test.resource_group_name
+  account_name        = azurerm_cosmosdb_account.test.name
+  database_name       = azurerm_cosmosdb_sql_database.test.name
+  partition_key_path  = "/definition/id"
+
+  conflict_resolution_policy {
+    mode                     = "LastWriterWins"
+    conflict_resolution_path = "/definition/updatedAt"
+  }
+}
+`, CosmosSqlDatabaseResource{}.basic(data), data.RandomInteger)
+}
+
+func (CosmosSqlContainerResource) conflictResolutionPolicyMissingPath(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_cosmosdb_sql_container" "test" {
+  name                = "acctest-CSQLC-%[2]d"
+  resource_group_name = azurerm_cosmosdb_account.test.resource_group_name
+  account_name        = azurerm_cosmosdb_account.test.name
+  database_name       = azurerm_cosmosdb_sql_database.test.name
+  partition_key_path  = "/definition/id"
+
+  conflict_resolution_policy {
+    mode = "LastWriterWins"
+  }
+}
+`, CosmosSqlDatabaseResource{}.basic(data), data.RandomInteger)
+}
@@ -149,11 +149,6 @@ func resourceCosmosDbSQLDatabaseUpdate(d *schema.ResourceData, meta interface{})
 		return err
 	}
 
-	err = common.CheckForChangeFromAutoscaleAndManualThroughput(d)
-	if err != nil {
-		return fmt.Errorf("Error updating Cosmos SQL Database %q (Account: %q) - %+v", id.Name, id.DatabaseAccountName, err)
-	}
-
 	db := documentdb.SQLDatabaseCreateUpdateParameters{
 		SQLDatabaseCreateUpdateProperties: &documentdb.SQLDatabaseCreateUpdateProperties{
 			Resource: &documentdb.SQLDatabaseResource{
@@ -172,8 +167,32 @@ func resourceCosmosDbSQLDatabaseUpdate(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("Error waiting on create/update future for Cosmos SQL Database %q (Account: %q): %+v", id.Name, id.DatabaseAccountName, err)
 	}
 
-	if common.HasThroughputChange(d) {
-		throughputParameters := common.ExpandCosmosDBThroughputSettingsUpdateParameters(d)
+	if common.HasChangeFromManualToAutoscaleThroughput(d) {
+		migrateFuture, err := client.MigrateSQLDatabaseToAutoscale(ctx, id.ResourceGroup, id.DatabaseAccountName, id.Name)
+		if err != nil {
+			return fmt.Errorf("migrating Throughput for Cosmos SQL Database %q (Account: %q) to Autoscale: %+v", id.Name, id.DatabaseAccountName, err)
+		}
+
+		if err = migrateFuture.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting for the migration of Throughput for Cosmos SQL Database %q (Account: %q) to Autoscale: %+v", id.Name, id.DatabaseAccountName, err)
+		}
+	}
+
+	if common.HasChangeFromAutoscaleToManualThroughput(d) {
+		migrateFuture, err := client.MigrateSQLDatabaseToManualThroughput(ctx, id.ResourceGroup, id.DatabaseAccountName, id.Name)
+		if err != nil {
+			return fmt.Errorf("migrating Throughput for Cosmos SQL Database %q (Account: %q) to Manual: %+v", id.Name, id.DatabaseAccountName, err)
+		}
+
+		if err = migrateFuture.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting for the migration of Throughput for Cosmos SQL Database %q (Account: %q) to Manual: %+v", id.Name, id.DatabaseAccountName, err)
+		}
+	}
+
+	throughputParameters := common.ExpandCosmosDBThroughputSettingsUpdateParameters(d)
+	// once migrated to manual throughput there's nothing further to update unless a throughput has been specified
+	hasThroughputSettings := throughputParameters.Resource.Throughput != nil || throughputParameters.Resource.AutoscaleSettings != nil
+	if common.HasThroughputChange(d) && hasThroughputSettings {
 		throughputFuture, err := client.UpdateSQLDatabaseThroughput(ctx, id.ResourceGroup, id.DatabaseAccountName, id.Name, *throughputParameters)
 		if err != nil {
 			if response.WasNotFound(throughputFuture.Response()) {
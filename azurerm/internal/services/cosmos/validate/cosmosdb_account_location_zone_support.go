@@ -0,0 +1,55 @@
+package validate
+
+import (
+	"fmt"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/location"
+)
+
+// CosmosDBAccountLocationZoneSupport - validates that the passed location supports zone redundancy or not
+func CosmosDBAccountLocationZoneSupport(input string) error {
+	location := location.Normalize(input)
+	invalidLocations := invalidCosmosDBAccountZoneLocations()
+
+	for _, str := range invalidLocations {
+		if location == str {
+			return fmt.Errorf("Zone Redundancy is not currently supported in the %s regions, got %q", azure.QuotedStringSlice(friendlyInvalidCosmosDBAccountZoneLocations()), location)
+		}
+	}
+
+	return nil
+}
+
+func invalidCosmosDBAccountZoneLocations() []string {
+	var invalidZone []string
+
+	for _, v := range friendlyInvalidCosmosDBAccountZoneLocations() {
+		invalidZone = append(invalidZone, location.Normalize(v))
+	}
+
+	return invalidZone
+}
+
+func friendlyInvalidCosmosDBAccountZoneLocations() []string {
+	return []string{
+		"Australia Central",
+		"Australia Southeast",
+		"Brazil Southeast",
+		"Canada East",
+		"France South",
+		"Germany North",
+		"Japan West",
+		"Korea South",
+		"North Central US",
+		"Norway West",
+		"South Africa West",
+		"South India",
+		"Switzerland West",
+		"UAE Central",
+		"UK West",
+		"West Central US",
+		"West India",
+		"West US",
+	}
+}
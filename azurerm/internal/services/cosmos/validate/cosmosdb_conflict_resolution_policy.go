@@ -0,0 +1,30 @@
+package validate
+
+import (
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/cosmos-db/mgmt/2020-04-01-preview/documentdb"
+)
+
+// CosmosDbConflictResolutionPolicy - validates that the fields of a Conflict Resolution Policy match its mode
+func CosmosDbConflictResolutionPolicy(mode string, conflictResolutionPath string, conflictResolutionProcedure string) error {
+	switch documentdb.ConflictResolutionMode(mode) {
+	case documentdb.LastWriterWins:
+		if conflictResolutionPath == "" {
+			return fmt.Errorf("`conflict_resolution_path` must be set when `mode` is %q", string(documentdb.LastWriterWins))
+		}
+		if conflictResolutionProcedure != "" {
+			return fmt.Errorf("`conflict_resolution_procedure` cannot be set when `mode` is %q", string(documentdb.LastWriterWins))
+		}
+
+	case documentdb.Custom:
+		if conflictResolutionPath != "" {
+			return fmt.Errorf("`conflict_resolution_path` cannot be set when `mode` is %q", string(documentdb.Custom))
+		}
+
+	default:
+		return fmt.Errorf("`mode` must be one of %q or %q, got %q", string(documentdb.LastWriterWins), string(documentdb.Custom), mode)
+	}
+
+	return nil
+}
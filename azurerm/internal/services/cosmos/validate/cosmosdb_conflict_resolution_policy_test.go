@@ -0,0 +1,78 @@
+package validate
+
+import "testing"
+
+func TestCosmosDbConflictResolutionPolicy(t *testing.T) {
+	cases := []struct {
+		Mode      string
+		Path      string
+		Procedure string
+		Valid     bool
+	}{
+		{
+			// Last Writer Wins on the default path
+			Mode:  "LastWriterWins",
+			Path:  "/_ts",
+			Valid: true,
+		},
+		{
+			// Last Writer Wins on a custom path
+			Mode:  "LastWriterWins",
+			Path:  "/updatedAt",
+			Valid: true,
+		},
+		{
+			// Last Writer Wins without a path
+			Mode:  "LastWriterWins",
+			Valid: false,
+		},
+		{
+			// Last Writer Wins with a procedure
+			Mode:      "LastWriterWins",
+			Path:      "/_ts",
+			Procedure: "dbs/db/colls/coll/sprocs/resolver",
+			Valid:     false,
+		},
+		{
+			// Custom with a procedure
+			Mode:      "Custom",
+			Procedure: "dbs/db/colls/coll/sprocs/resolver",
+			Valid:     true,
+		},
+		{
+			// Custom without a procedure, where conflicts are written to the conflicts feed
+			Mode:  "Custom",
+			Valid: true,
+		},
+		{
+			// Custom with a path
+			Mode:      "Custom",
+			Path:      "/_ts",
+			Procedure: "dbs/db/colls/coll/sprocs/resolver",
+			Valid:     false,
+		},
+		{
+			// Lower case mode
+			Mode:  "lastwriterwins",
+			Path:  "/_ts",
+			Valid: false,
+		},
+		{
+			// empty
+			Mode:  "",
+			Valid: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing Mode %q (Path %q / Procedure %q)", tc.Mode, tc.Path, tc.Procedure)
+		var valid bool
+		if err := CosmosDbConflictResolutionPolicy(tc.Mode, tc.Path, tc.Procedure); err == nil {
+			valid = true
+		}
+
+		if tc.Valid != valid {
+			t.Fatalf("Expected %t but got %t", tc.Valid, valid)
+		}
+	}
+}
@@ -0,0 +1,79 @@
+package eventhub
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/eventhub/mgmt/2018-01-01-preview/eventhub"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestEventHubNamespaceDisasterRecoveryConfigRefreshFunc(t *testing.T) {
+	responses := []eventhub.ArmDisasterRecovery{
+		{
+			ArmDisasterRecoveryProperties: &eventhub.ArmDisasterRecoveryProperties{
+				ProvisioningState: eventhub.ProvisioningStateDRAccepted,
+			},
+		},
+		{
+			ArmDisasterRecoveryProperties: &eventhub.ArmDisasterRecoveryProperties{
+				ProvisioningState:                 eventhub.ProvisioningStateDRSucceeded,
+				PendingReplicationOperationsCount: utils.Int64(3),
+			},
+		},
+		{
+			ArmDisasterRecoveryProperties: &eventhub.ArmDisasterRecoveryProperties{
+				ProvisioningState:                 eventhub.ProvisioningStateDRSucceeded,
+				PendingReplicationOperationsCount: utils.Int64(0),
+			},
+		},
+	}
+
+	calls := 0
+	get := func() (eventhub.ArmDisasterRecovery, error) {
+		if calls >= len(responses) {
+			return eventhub.ArmDisasterRecovery{}, fmt.Errorf("unexpected call %d", calls)
+		}
+
+		resp := responses[calls]
+		calls++
+		return resp, nil
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{string(eventhub.ProvisioningStateDRAccepted), "Replicating"},
+		Target:       []string{string(eventhub.ProvisioningStateDRSucceeded)},
+		PollInterval: time.Millisecond,
+		Timeout:      time.Minute,
+		Refresh:      eventHubNamespaceDisasterRecoveryConfigRefreshFunc(get, "group1", "namespace1", "alias1"),
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		t.Fatalf("unexpected error waiting for state: %+v", err)
+	}
+
+	if calls != len(responses) {
+		t.Fatalf("expected %d calls but got %d", len(responses), calls)
+	}
+}
+
+func TestEventHubNamespaceDisasterRecoveryConfigRefreshFunc_failed(t *testing.T) {
+	get := func() (eventhub.ArmDisasterRecovery, error) {
+		return eventhub.ArmDisasterRecovery{
+			ArmDisasterRecoveryProperties: &eventhub.ArmDisasterRecoveryProperties{
+				ProvisioningState: eventhub.ProvisioningStateDRFailed,
+			},
+		}, nil
+	}
+
+	_, state, err := eventHubNamespaceDisasterRecoveryConfigRefreshFunc(get, "group1", "namespace1", "alias1")()
+	if err == nil {
+		t.Fatalf("expected an error but didn't get one")
+	}
+
+	if state != "failed" {
+		t.Fatalf("expected state `failed` but got %q", state)
+	}
+}
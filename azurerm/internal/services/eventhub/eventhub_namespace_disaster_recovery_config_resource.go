@@ -296,27 +296,41 @@ func resourceEventHubNamespaceDisasterRecoveryConfigDelete(d *schema.ResourceDat
 
 func resourceEventHubNamespaceDisasterRecoveryConfigWaitForState(ctx context.Context, client *eventhub.DisasterRecoveryConfigsClient, resourceGroup, namespaceName, name string, timeout time.Duration) error {
 	stateConf := &resource.StateChangeConf{
-		Pending:    []string{string(eventhub.ProvisioningStateDRAccepted)},
+		Pending:    []string{string(eventhub.ProvisioningStateDRAccepted), "Replicating"},
 		Target:     []string{string(eventhub.ProvisioningStateDRSucceeded)},
 		MinTimeout: 30 * time.Second,
 		Timeout:    timeout,
-		Refresh: func() (interface{}, string, error) {
-			read, err := client.Get(ctx, resourceGroup, namespaceName, name)
-			if err != nil {
-				return nil, "error", fmt.Errorf("Wait read EventHub Namespace Disaster Recovery Configs %q (Namespace %q / Resource Group %q): %v", name, namespaceName, resourceGroup, err)
+		Refresh: eventHubNamespaceDisasterRecoveryConfigRefreshFunc(func() (eventhub.ArmDisasterRecovery, error) {
+			return client.Get(ctx, resourceGroup, namespaceName, name)
+		}, resourceGroup, namespaceName, name),
+	}
+
+	_, err := stateConf.WaitForState()
+	return err
+}
+
+// eventHubNamespaceDisasterRecoveryConfigRefreshFunc returns the provisioning state of the Disaster Recovery Config,
+// which is only considered `Succeeded` once there are no replication operations pending between the paired namespaces
+func eventHubNamespaceDisasterRecoveryConfigRefreshFunc(get func() (eventhub.ArmDisasterRecovery, error), resourceGroup, namespaceName, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		read, err := get()
+		if err != nil {
+			return nil, "error", fmt.Errorf("Wait read EventHub Namespace Disaster Recovery Configs %q (Namespace %q / Resource Group %q): %v", name, namespaceName, resourceGroup, err)
+		}
+
+		if props := read.ArmDisasterRecoveryProperties; props != nil {
+			if props.ProvisioningState == eventhub.ProvisioningStateDRFailed {
+				return read, "failed", fmt.Errorf("Replication for EventHub Namespace Disaster Recovery Configs %q (Namespace %q / Resource Group %q) failed!", name, namespaceName, resourceGroup)
 			}
 
-			if props := read.ArmDisasterRecoveryProperties; props != nil {
-				if props.ProvisioningState == eventhub.ProvisioningStateDRFailed {
-					return read, "failed", fmt.Errorf("Replication for EventHub Namespace Disaster Recovery Configs %q (Namespace %q / Resource Group %q) failed!", name, namespaceName, resourceGroup)
-				}
-				return read, string(props.ProvisioningState), nil
+			if props.ProvisioningState == eventhub.ProvisioningStateDRSucceeded && props.PendingReplicationOperationsCount != nil && *props.PendingReplicationOperationsCount > 0 {
+				log.Printf("[DEBUG] %d replication operations pending for EventHub Namespace Disaster Recovery Configs %q (Namespace %q / Resource Group %q)", *props.PendingReplicationOperationsCount, name, namespaceName, resourceGroup)
+				return read, "Replicating", nil
 			}
 
-			return read, "nil", fmt.Errorf("Waiting for replication error EventHub Namespace Disaster Recovery Configs %q (Namespace %q / Resource Group %q): provisioning state is nil", name, namespaceName, resourceGroup)
-		},
-	}
+			return read, string(props.ProvisioningState), nil
+		}
 
-	_, err := stateConf.WaitForState()
-	return err
+		return read, "nil", fmt.Errorf("Waiting for replication error EventHub Namespace Disaster Recovery Configs %q (Namespace %q / Resource Group %q): provisioning state is nil", name, namespaceName, resourceGroup)
+	}
 }
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/eventhub/mgmt/2018-01-01-preview/eventhub"
@@ -31,6 +32,9 @@ var (
 	eventHubNamespaceResourceName             = "azurerm_eventhub_namespace"
 )
 
+// the maximum number of throughput units a Standard namespace can Auto-Inflate to
+const eventHubNamespaceStandardMaximumThroughputUnits = 40
+
 func resourceEventHubNamespace() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceEventHubNamespaceCreateUpdate,
@@ -50,6 +54,8 @@ func resourceEventHubNamespace() *schema.Resource {
 			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
+		CustomizeDiff: resourceEventHubNamespaceCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -129,7 +135,7 @@ func resourceEventHubNamespace() *schema.Resource {
 				Type:         schema.TypeInt,
 				Optional:     true,
 				Computed:     true,
-				ValidateFunc: validation.IntBetween(0, 20),
+				ValidateFunc: validation.IntBetween(0, eventHubNamespaceStandardMaximumThroughputUnits),
 			},
 
 			"network_rulesets": {
@@ -355,6 +361,42 @@ func resourceEventHubNamespaceCreateUpdate(d *schema.ResourceData, meta interfac
 	return resourceEventHubNamespaceRead(d, meta)
 }
 
+func resourceEventHubNamespaceCustomizeDiff(d *schema.ResourceDiff, _ interface{}) error {
+	// these can't be validated until the values are known, e.g. when interpolated from another resource
+	for _, key := range []string{"sku", "capacity", "auto_inflate_enabled", "maximum_throughput_units"} {
+		if !d.NewValueKnown(key) {
+			return nil
+		}
+	}
+
+	return validateEventHubNamespaceThroughputUnits(d.Get("sku").(string), d.Get("capacity").(int), d.Get("auto_inflate_enabled").(bool), d.Get("maximum_throughput_units").(int))
+}
+
+func validateEventHubNamespaceThroughputUnits(sku string, capacity int, autoInflateEnabled bool, maximumThroughputUnits int) error {
+	if !autoInflateEnabled {
+		return nil
+	}
+
+	if strings.EqualFold(sku, string(eventhub.Basic)) {
+		return fmt.Errorf("`auto_inflate_enabled` can only be enabled for a `Standard` SKU namespace")
+	}
+
+	// when omitted the maximum is computed by the service
+	if maximumThroughputUnits == 0 {
+		return nil
+	}
+
+	if maximumThroughputUnits < capacity {
+		return fmt.Errorf("`maximum_throughput_units` (%d) must be greater than or equal to `capacity` (%d) when `auto_inflate_enabled` is set", maximumThroughputUnits, capacity)
+	}
+
+	if maximumThroughputUnits > eventHubNamespaceStandardMaximumThroughputUnits {
+		return fmt.Errorf("`maximum_throughput_units` (%d) must be at most %d for a `Standard` SKU namespace", maximumThroughputUnits, eventHubNamespaceStandardMaximumThroughputUnits)
+	}
+
+	return nil
+}
+
 func resourceEventHubNamespaceRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Eventhub.NamespacesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
@@ -0,0 +1,81 @@
+package eventhub
+
+import "testing"
+
+func TestValidateEventHubNamespaceThroughputUnits(t *testing.T) {
+	testData := []struct {
+		name                   string
+		sku                    string
+		capacity               int
+		autoInflateEnabled     bool
+		maximumThroughputUnits int
+		valid                  bool
+	}{
+		{
+			name:                   "Auto Inflate disabled",
+			sku:                    "Standard",
+			capacity:               10,
+			autoInflateEnabled:     false,
+			maximumThroughputUnits: 0,
+			valid:                  true,
+		},
+		{
+			name:                   "Maximum omitted",
+			sku:                    "Standard",
+			capacity:               10,
+			autoInflateEnabled:     true,
+			maximumThroughputUnits: 0,
+			valid:                  true,
+		},
+		{
+			name:                   "Maximum equal to the capacity",
+			sku:                    "Standard",
+			capacity:               10,
+			autoInflateEnabled:     true,
+			maximumThroughputUnits: 10,
+			valid:                  true,
+		},
+		{
+			name:                   "Maximum above the capacity",
+			sku:                    "Standard",
+			capacity:               2,
+			autoInflateEnabled:     true,
+			maximumThroughputUnits: 40,
+			valid:                  true,
+		},
+		{
+			name:                   "Maximum below the capacity",
+			sku:                    "Standard",
+			capacity:               10,
+			autoInflateEnabled:     true,
+			maximumThroughputUnits: 5,
+			valid:                  false,
+		},
+		{
+			name:                   "Maximum above the SKU limit",
+			sku:                    "Standard",
+			capacity:               10,
+			autoInflateEnabled:     true,
+			maximumThroughputUnits: 41,
+			valid:                  false,
+		},
+		{
+			name:                   "Auto Inflate on a Basic SKU",
+			sku:                    "basic",
+			capacity:               1,
+			autoInflateEnabled:     true,
+			maximumThroughputUnits: 2,
+			valid:                  false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.name)
+
+		err := validateEventHubNamespaceThroughputUnits(v.sku, v.capacity, v.autoInflateEnabled, v.maximumThroughputUnits)
+		valid := err == nil
+		if valid != v.valid {
+			t.Fatalf("Expected %t but got %t for %q: %+v", v.valid, valid, v.name, err)
+		}
+	}
+}
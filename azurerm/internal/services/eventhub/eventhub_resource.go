@@ -1,6 +1,7 @@
 package eventhub
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -60,7 +61,6 @@ func resourceEventHub() *schema.Resource {
 			"partition_count": {
 				Type:         schema.TypeInt,
 				Required:     true,
-				ForceNew:     true,
 				ValidateFunc: validate.ValidateEventHubPartitionCount,
 			},
 
@@ -150,7 +150,43 @@ func resourceEventHub() *schema.Resource {
 				Computed: true,
 			},
 		},
+
+		CustomizeDiff: resourceEventHubCustomizeDiff,
+	}
+}
+
+func resourceEventHubCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.HasChange("partition_count") {
+		return nil
+	}
+
+	// the partition count can only ever be increased in-place, and only within a Dedicated Cluster or a Premium Namespace
+	oldCount, newCount := d.GetChange("partition_count")
+	if newCount.(int) < oldCount.(int) || d.HasChange("namespace_name") || d.HasChange("resource_group_name") {
+		return d.ForceNew("partition_count")
+	}
+
+	client := meta.(*clients.Client).Eventhub.NamespacesClient
+	ctx, cancel := context.WithTimeout(meta.(*clients.Client).StopContext, 5*time.Minute)
+	defer cancel()
+
+	resourceGroup := d.Get("resource_group_name").(string)
+	namespaceName := d.Get("namespace_name").(string)
+	namespace, err := client.Get(ctx, resourceGroup, namespaceName)
+	if err != nil {
+		return fmt.Errorf("retrieving EventHub Namespace %q (Resource Group %q): %+v", namespaceName, resourceGroup, err)
+	}
+
+	// the Premium SKU isn't exposed by this version of the SDK
+	if sku := namespace.Sku; sku != nil && sku.Name == eventhub.SkuName("Premium") {
+		return nil
 	}
+
+	if props := namespace.EHNamespaceProperties; props == nil || props.ClusterArmID == nil || *props.ClusterArmID == "" {
+		return d.ForceNew("partition_count")
+	}
+
+	return nil
 }
 
 func resourceEventHubCreateUpdate(d *schema.ResourceData, meta interface{}) error {
@@ -248,6 +248,31 @@ func TestAccEventHub_partitionCountUpdate(t *testing.T) {
 	})
 }
 
+func TestAccEventHub_partitionCountIncreaseDedicated(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_eventhub", "test")
+	r := EventHubResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.dedicated(data, 2),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("partition_count").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.dedicated(data, 10),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("partition_count").HasValue("10"),
+				check.That(data.ResourceName).Key("partition_ids.#").HasValue("10"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccEventHub_standard(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_eventhub", "test")
 	r := EventHubResource{}
@@ -409,6 +434,43 @@ resource "azurerm_eventhub" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger)
 }
 
+func (EventHubResource) dedicated(data acceptance.TestData, partitionCount int) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-eventhub-%d"
+  location = "%s"
+}
+
+resource "azurerm_eventhub_cluster" "test" {
+  name                = "acctesteventhubcluster-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  sku_name            = "Dedicated_1"
+}
+
+resource "azurerm_eventhub_namespace" "test" {
+  name                 = "acctesteventhubnamespace-%d"
+  location             = azurerm_resource_group.test.location
+  resource_group_name  = azurerm_resource_group.test.name
+  sku                  = "Standard"
+  capacity             = "2"
+  dedicated_cluster_id = azurerm_eventhub_cluster.test.id
+}
+
+resource "azurerm_eventhub" "test" {
+  name                = "acctesteventhub-%d"
+  namespace_name      = azurerm_eventhub_namespace.test.name
+  resource_group_name = azurerm_resource_group.test.name
+  partition_count     = %d
+  message_retention   = 1
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, data.RandomInteger, partitionCount)
+}
+
 func (EventHubResource) standard(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -4,10 +4,12 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net"
 	"net/http"
 	"strings"
 	"time"
 
+	"github.com/Azure/azure-sdk-for-go/services/keyvault/mgmt/2019-09-01/keyvault"
 	"github.com/Azure/go-autorest/autorest"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -154,3 +156,91 @@ func nestedItemResourceImporter(d *schema.ResourceData, meta interface{}) ([]*sc
 
 	return []*schema.ResourceData{d}, nil
 }
+
+// validateKeyVaultAccessPoliciesWithRbac returns an error when Access Policies exist for a Key Vault using RBAC
+// Authorization, since these are ignored by Azure for data plane operations in this mode. Since `access_policy` is
+// Computed these may have been created by the `azurerm_key_vault_access_policy` resource rather than being configured
+func validateKeyVaultAccessPoliciesWithRbac(rbacAuthorizationEnabled bool, accessPolicies []interface{}) error {
+	if rbacAuthorizationEnabled && len(accessPolicies) > 0 {
+		return fmt.Errorf("Access Policies exist on this Key Vault but are ignored when `enable_rbac_authorization` is set to `true` - remove any `access_policy` blocks and `azurerm_key_vault_access_policy` resources, and set `access_policy = []` to clear the existing Access Policies within the same apply")
+	}
+
+	return nil
+}
+
+// validateKeyVaultNetworkAcls returns an error when an `ip_rules` entry within the `network_acls` block is
+// neither an IPv4 Address nor a CIDR Block
+func validateKeyVaultNetworkAcls(input []interface{}) error {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	ipRules, ok := v["ip_rules"].(*schema.Set)
+	if !ok {
+		return nil
+	}
+
+	for _, raw := range ipRules.List() {
+		rule := raw.(string)
+
+		ip := net.ParseIP(rule)
+		if ip == nil {
+			parsedIp, _, err := net.ParseCIDR(rule)
+			if err != nil {
+				return fmt.Errorf("`network_acls.0.ip_rules` contains %q which is neither a valid IP Address nor a CIDR Block", rule)
+			}
+			ip = parsedIp
+		}
+
+		if ip.To4() == nil {
+			return fmt.Errorf("`network_acls.0.ip_rules` contains %q which isn't an IPv4 Address or CIDR Block", rule)
+		}
+	}
+
+	return nil
+}
+
+// keyVaultNetworkAclsWarnings returns warnings for `network_acls` configurations which are accepted by Azure but
+// are likely to break integrations with other Azure Services
+func keyVaultNetworkAclsWarnings(input []interface{}) []string {
+	warnings := make([]string, 0)
+	if len(input) == 0 || input[0] == nil {
+		return warnings
+	}
+
+	v := input[0].(map[string]interface{})
+	defaultAction := v["default_action"].(string)
+	bypass := v["bypass"].(string)
+
+	if strings.EqualFold(defaultAction, string(keyvault.Deny)) && strings.EqualFold(bypass, string(keyvault.None)) {
+		warnings = append(warnings, "`network_acls.0.default_action` is `Deny` and `network_acls.0.bypass` is `None` - trusted Azure Services (for example Azure Backup, Disk Encryption or App Service) won't be able to access this Key Vault, even when a Private Endpoint is used. Set `bypass` to `AzureServices` if these integrations are required")
+	}
+
+	return warnings
+}
+
+// flattenKeyVaultEnabledForFlags returns the `enabled_for_*` flags of a Key Vault - Azure can return these as null
+// rather than false when they've not been enabled, which would otherwise cause a perpetual diff
+func flattenKeyVaultEnabledForFlags(props *keyvault.VaultProperties) map[string]bool {
+	flags := map[string]bool{
+		"enabled_for_deployment":          false,
+		"enabled_for_disk_encryption":     false,
+		"enabled_for_template_deployment": false,
+	}
+	if props == nil {
+		return flags
+	}
+
+	if props.EnabledForDeployment != nil {
+		flags["enabled_for_deployment"] = *props.EnabledForDeployment
+	}
+	if props.EnabledForDiskEncryption != nil {
+		flags["enabled_for_disk_encryption"] = *props.EnabledForDiskEncryption
+	}
+	if props.EnabledForTemplateDeployment != nil {
+		flags["enabled_for_template_deployment"] = *props.EnabledForTemplateDeployment
+	}
+
+	return flags
+}
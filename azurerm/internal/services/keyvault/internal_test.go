@@ -0,0 +1,378 @@
+package keyvault
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/keyvault/mgmt/2019-09-01/keyvault"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestValidateKeyVaultAccessPoliciesWithRbac(t *testing.T) {
+	policy := map[string]interface{}{
+		"tenant_id": "00000000-0000-0000-0000-000000000000",
+		"object_id": "11111111-1111-1111-1111-111111111111",
+	}
+
+	tests := []struct {
+		name           string
+		rbacEnabled    bool
+		accessPolicies []interface{}
+		shouldError    bool
+	}{
+		{
+			name:           "access policies without rbac",
+			rbacEnabled:    false,
+			accessPolicies: []interface{}{policy},
+			shouldError:    false,
+		},
+		{
+			name:           "rbac without access policies",
+			rbacEnabled:    true,
+			accessPolicies: []interface{}{},
+			shouldError:    false,
+		},
+		{
+			name:           "neither",
+			rbacEnabled:    false,
+			accessPolicies: nil,
+			shouldError:    false,
+		},
+		{
+			name:           "rbac with access policies",
+			rbacEnabled:    true,
+			accessPolicies: []interface{}{policy},
+			shouldError:    true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := validateKeyVaultAccessPoliciesWithRbac(test.rbacEnabled, test.accessPolicies)
+			if test.shouldError && err == nil {
+				t.Fatalf("expected an error but didn't get one")
+			}
+			if !test.shouldError && err != nil {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+		})
+	}
+}
+
+func TestKeyVaultCustomizeDiffEnablingRbacWithAccessPolicies(t *testing.T) {
+	keyVaultSchema := resourceKeyVault().Schema
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"access_policy":             keyVaultSchema["access_policy"],
+			"enable_rbac_authorization": keyVaultSchema["enable_rbac_authorization"],
+			"network_acls":              keyVaultSchema["network_acls"],
+		},
+		CustomizeDiff: resourceKeyVaultCustomizeDiff,
+	}
+
+	accessPolicy := map[string]interface{}{
+		"tenant_id":          "00000000-0000-0000-0000-000000000000",
+		"object_id":          "11111111-1111-1111-1111-111111111111",
+		"secret_permissions": []interface{}{"get"},
+	}
+
+	d := r.TestResourceData()
+	d.SetId("test")
+	if err := d.Set("access_policy", []interface{}{accessPolicy}); err != nil {
+		t.Fatalf("setting `access_policy`: %+v", err)
+	}
+	if err := d.Set("enable_rbac_authorization", false); err != nil {
+		t.Fatalf("setting `enable_rbac_authorization`: %+v", err)
+	}
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"access_policy":             []interface{}{accessPolicy},
+		"enable_rbac_authorization": true,
+	})
+	if _, err := r.Diff(d.State(), config, nil); err == nil {
+		t.Fatalf("expected an error when enabling RBAC Authorization for a Key Vault with Access Policies but didn't get one")
+	}
+
+	config = terraform.NewResourceConfigRaw(map[string]interface{}{
+		"access_policy":             []interface{}{accessPolicy},
+		"enable_rbac_authorization": false,
+	})
+	if _, err := r.Diff(d.State(), config, nil); err != nil {
+		t.Fatalf("expected no error when RBAC Authorization is unchanged but got: %+v", err)
+	}
+}
+
+func TestKeyVaultCustomizeDiffEnablingRbacWithAccessPolicyResources(t *testing.T) {
+	keyVaultSchema := resourceKeyVault().Schema
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"access_policy":             keyVaultSchema["access_policy"],
+			"enable_rbac_authorization": keyVaultSchema["enable_rbac_authorization"],
+			"network_acls":              keyVaultSchema["network_acls"],
+		},
+		CustomizeDiff: resourceKeyVaultCustomizeDiff,
+	}
+
+	// the Access Policy was created by the `azurerm_key_vault_access_policy` resource, so is only present in the state
+	d := r.TestResourceData()
+	d.SetId("test")
+	if err := d.Set("access_policy", []interface{}{
+		map[string]interface{}{
+			"tenant_id":          "00000000-0000-0000-0000-000000000000",
+			"object_id":          "11111111-1111-1111-1111-111111111111",
+			"secret_permissions": []interface{}{"get"},
+		},
+	}); err != nil {
+		t.Fatalf("setting `access_policy`: %+v", err)
+	}
+	if err := d.Set("enable_rbac_authorization", false); err != nil {
+		t.Fatalf("setting `enable_rbac_authorization`: %+v", err)
+	}
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"enable_rbac_authorization": true,
+	})
+	if _, err := r.Diff(d.State(), config, nil); err == nil {
+		t.Fatalf("expected an error when enabling RBAC Authorization for a Key Vault with existing Access Policies but didn't get one")
+	}
+
+	// migrating to RBAC Authorization by clearing the existing Access Policies within the same apply
+	config = terraform.NewResourceConfigRaw(map[string]interface{}{
+		"access_policy":             []interface{}{},
+		"enable_rbac_authorization": true,
+	})
+	if _, err := r.Diff(d.State(), config, nil); err != nil {
+		t.Fatalf("expected no error when clearing the Access Policies whilst enabling RBAC Authorization but got: %+v", err)
+	}
+}
+
+func TestValidateKeyVaultNetworkAcls(t *testing.T) {
+	tests := []struct {
+		name          string
+		defaultAction string
+		ipRules       []interface{}
+		shouldError   bool
+	}{
+		{
+			name:          "no ip rules",
+			defaultAction: "Deny",
+			ipRules:       []interface{}{},
+			shouldError:   false,
+		},
+		{
+			name:          "ip addresses and cidrs",
+			defaultAction: "Deny",
+			ipRules:       []interface{}{"52.0.0.1", "52.0.0.0/24", "0.0.0.0/0", "10.0.0.0/8"},
+			shouldError:   false,
+		},
+		{
+			name:          "allow with ip rules",
+			defaultAction: "Allow",
+			ipRules:       []interface{}{"123.0.0.102/32", "123.0.0.101"},
+			shouldError:   false,
+		},
+		{
+			name:          "malformed cidr",
+			defaultAction: "Deny",
+			ipRules:       []interface{}{"52.0.0.0/33"},
+			shouldError:   true,
+		},
+		{
+			name:          "ipv6 address",
+			defaultAction: "Deny",
+			ipRules:       []interface{}{"2001:db8::1"},
+			shouldError:   true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			input := []interface{}{
+				map[string]interface{}{
+					"default_action": test.defaultAction,
+					"bypass":         "AzureServices",
+					"ip_rules":       schema.NewSet(schema.HashString, test.ipRules),
+				},
+			}
+
+			err := validateKeyVaultNetworkAcls(input)
+			if test.shouldError && err == nil {
+				t.Fatalf("expected an error but didn't get one")
+			}
+			if !test.shouldError && err != nil {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+		})
+	}
+}
+
+func TestKeyVaultNetworkAclsWarnings(t *testing.T) {
+	tests := []struct {
+		name          string
+		defaultAction string
+		bypass        string
+		warnings      int
+	}{
+		{
+			name:          "deny with azure services bypass",
+			defaultAction: "Deny",
+			bypass:        "AzureServices",
+			warnings:      0,
+		},
+		{
+			name:          "deny without bypass",
+			defaultAction: "Deny",
+			bypass:        "None",
+			warnings:      1,
+		},
+		{
+			name:          "allow without bypass",
+			defaultAction: "Allow",
+			bypass:        "None",
+			warnings:      0,
+		},
+		{
+			name:          "allow with azure services bypass",
+			defaultAction: "Allow",
+			bypass:        "AzureServices",
+			warnings:      0,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			input := []interface{}{
+				map[string]interface{}{
+					"default_action": test.defaultAction,
+					"bypass":         test.bypass,
+				},
+			}
+
+			warnings := keyVaultNetworkAclsWarnings(input)
+			if len(warnings) != test.warnings {
+				t.Fatalf("expected %d warnings but got %d: %+v", test.warnings, len(warnings), warnings)
+			}
+		})
+	}
+}
+
+func TestKeyVaultCustomizeDiffNetworkAclsDenyWithoutBypass(t *testing.T) {
+	keyVaultSchema := resourceKeyVault().Schema
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name":                      keyVaultSchema["name"],
+			"access_policy":             keyVaultSchema["access_policy"],
+			"enable_rbac_authorization": keyVaultSchema["enable_rbac_authorization"],
+			"network_acls":              keyVaultSchema["network_acls"],
+		},
+		CustomizeDiff: resourceKeyVaultCustomizeDiff,
+	}
+
+	// `default_action` set to `Deny` with `bypass` set to `None` is only warned about, since it's valid when a
+	// Private Endpoint is used
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name": "example",
+		"network_acls": []interface{}{
+			map[string]interface{}{
+				"default_action": "Deny",
+				"bypass":         "None",
+				"ip_rules":       []interface{}{"52.0.0.1"},
+			},
+		},
+	})
+	if _, err := r.Diff(nil, config, nil); err != nil {
+		t.Fatalf("expected no error when `default_action` is `Deny` and `bypass` is `None` but got: %+v", err)
+	}
+}
+
+func TestFlattenKeyVaultEnabledForFlags(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    *keyvault.VaultProperties
+		expected map[string]bool
+	}{
+		{
+			name:  "nil",
+			input: nil,
+			expected: map[string]bool{
+				"enabled_for_deployment":          false,
+				"enabled_for_disk_encryption":     false,
+				"enabled_for_template_deployment": false,
+			},
+		},
+		{
+			name:  "null values",
+			input: &keyvault.VaultProperties{},
+			expected: map[string]bool{
+				"enabled_for_deployment":          false,
+				"enabled_for_disk_encryption":     false,
+				"enabled_for_template_deployment": false,
+			},
+		},
+		{
+			name: "mixed values",
+			input: &keyvault.VaultProperties{
+				EnabledForDeployment:         utils.Bool(true),
+				EnabledForTemplateDeployment: utils.Bool(false),
+			},
+			expected: map[string]bool{
+				"enabled_for_deployment":          true,
+				"enabled_for_disk_encryption":     false,
+				"enabled_for_template_deployment": false,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual := flattenKeyVaultEnabledForFlags(test.input)
+			if !reflect.DeepEqual(actual, test.expected) {
+				t.Fatalf("expected %+v but got %+v", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestKeyVaultEnabledForFlagsNullValuesHaveNoDiff(t *testing.T) {
+	keyVaultSchema := resourceKeyVault().Schema
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"enabled_for_deployment":          keyVaultSchema["enabled_for_deployment"],
+			"enabled_for_disk_encryption":     keyVaultSchema["enabled_for_disk_encryption"],
+			"enabled_for_template_deployment": keyVaultSchema["enabled_for_template_deployment"],
+		},
+	}
+
+	// the API returns null for `enabledForDiskEncryption` when it's not been enabled
+	d := r.TestResourceData()
+	d.SetId("test")
+	for key, value := range flattenKeyVaultEnabledForFlags(&keyvault.VaultProperties{
+		EnabledForDeployment:         utils.Bool(true),
+		EnabledForTemplateDeployment: utils.Bool(false),
+	}) {
+		if err := d.Set(key, value); err != nil {
+			t.Fatalf("setting %q: %+v", key, err)
+		}
+	}
+
+	state := d.State()
+	if v, ok := state.Attributes["enabled_for_disk_encryption"]; !ok || v != "false" {
+		t.Fatalf("expected `enabled_for_disk_encryption` to be stored as `false` but got %q", v)
+	}
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"enabled_for_deployment":          true,
+		"enabled_for_disk_encryption":     false,
+		"enabled_for_template_deployment": false,
+	})
+	diff, err := r.Diff(state, config, nil)
+	if err != nil {
+		t.Fatalf("computing diff: %+v", err)
+	}
+
+	if diff != nil && len(diff.Attributes) > 0 {
+		t.Fatalf("expected no diff but got: %+v", diff.Attributes)
+	}
+}
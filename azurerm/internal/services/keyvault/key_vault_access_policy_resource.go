@@ -138,6 +138,10 @@ func resourceKeyVaultAccessPolicyCreateOrDelete(d *schema.ResourceData, meta int
 			return fmt.Errorf("Error parsing Key Vault: `properties` was nil")
 		}
 
+		if props.EnableRbacAuthorization != nil && *props.EnableRbacAuthorization {
+			return fmt.Errorf("Access Policies cannot be added to Key Vault %q (Resource Group %q) since it uses RBAC Authorization (`enable_rbac_authorization`)", vaultName, resourceGroup)
+		}
+
 		if props.AccessPolicies == nil {
 			return fmt.Errorf("Error parsing Key Vault: `properties.AccessPolicy` was nil")
 		}
@@ -3,6 +3,7 @@ package keyvault
 import (
 	"context"
 	"crypto/x509"
+	"encoding/asn1"
 	"encoding/base64"
 	"encoding/hex"
 	"fmt"
@@ -351,6 +352,11 @@ func resourceKeyVaultCertificate() *schema.Resource {
 				Computed: true,
 			},
 
+			"certificate_signing_request": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
 			"tags": tags.ForceNewSchema(),
 		},
 	}
@@ -387,6 +393,20 @@ func resourceKeyVaultCertificateCreate(d *schema.ResourceData, meta interface{})
 	t := d.Get("tags").(map[string]interface{})
 	policy := expandKeyVaultCertificatePolicy(d)
 
+	if issuer := policy.IssuerParameters; issuer != nil && issuer.Name != nil && !keyVaultCertificateIssuerIsBuiltIn(*issuer.Name) {
+		// external issuers (e.g. DigiCert) have to be configured in the Key Vault before a Certificate can reference them
+		resp, err := client.GetCertificateIssuer(ctx, *keyVaultBaseUrl, *issuer.Name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Certificate Issuer %q was not found in Key Vault %q - it must be configured (for example using the `azurerm_key_vault_certificate_issuer` resource) before it can be referenced in `issuer_parameters`", *issuer.Name, *keyVaultBaseUrl)
+			}
+			if !utils.ResponseWasForbidden(resp.Response) {
+				return fmt.Errorf("retrieving Certificate Issuer %q in Key Vault %q: %+v", *issuer.Name, *keyVaultBaseUrl, err)
+			}
+			log.Printf("[DEBUG] Unable to retrieve Certificate Issuer %q in Key Vault %q since the `GetIssuers` permission is missing - skipping validation", *issuer.Name, *keyVaultBaseUrl)
+		}
+	}
+
 	if v, ok := d.GetOk("certificate"); ok {
 		// Import
 		certificate := expandKeyVaultCertificate(v)
@@ -444,7 +464,7 @@ func resourceKeyVaultCertificateCreate(d *schema.ResourceData, meta interface{})
 		// It has been observed that at least one certificate issuer responds to a request with manual processing by issuer staff. SLA's may differ among issuers.
 		// The total create timeout duration is divided by a modified poll interval of 30s to calculate the number of times to allow not found instead of the default 20.
 		// Using math.Floor, the calculation will err on the lower side of the creation timeout, so as to return before the overall create timeout occurs.
-		if policy.IssuerParameters != nil && policy.IssuerParameters.Name != nil && *policy.IssuerParameters.Name != "Self" {
+		if policy.IssuerParameters != nil && policy.IssuerParameters.Name != nil && !keyVaultCertificateIssuerIsBuiltIn(*policy.IssuerParameters.Name) {
 			stateConf.PollInterval = 30 * time.Second
 			stateConf.NotFoundChecks = int(math.Floor(float64(stateConf.Timeout) / float64(stateConf.PollInterval)))
 		}
@@ -479,6 +499,17 @@ func keyVaultCertificateCreationRefreshFunc(ctx context.Context, client *keyvaul
 		}
 
 		if res.Sid == nil || *res.Sid == "" {
+			// when an external issuer rejects the request the Certificate is never issued, so surface the error rather than waiting for the timeout
+			operation, err := client.GetCertificateOperation(ctx, keyVaultBaseUrl, name)
+			if err != nil {
+				log.Printf("[DEBUG] Unable to retrieve the pending operation for Certificate %q in Vault %q: %+v", name, keyVaultBaseUrl, err)
+				return nil, "Provisioning", nil
+			}
+
+			if operation.Status != nil && strings.EqualFold(*operation.Status, "failed") {
+				return nil, "", fmt.Errorf("issuing Certificate %q in Vault %q failed: %s", name, keyVaultBaseUrl, keyVaultCertificateOperationErrorMessage(operation))
+			}
+
 			return nil, "Provisioning", nil
 		}
 
@@ -486,6 +517,24 @@ func keyVaultCertificateCreationRefreshFunc(ctx context.Context, client *keyvaul
 	}
 }
 
+// keyVaultCertificateIssuerIsBuiltIn returns whether the issuer is one of the reserved names which
+// don't need a Certificate Issuer to be configured in the Key Vault
+func keyVaultCertificateIssuerIsBuiltIn(name string) bool {
+	return strings.EqualFold(name, "Self") || strings.EqualFold(name, "Unknown")
+}
+
+func keyVaultCertificateOperationErrorMessage(operation keyvault.CertificateOperation) string {
+	if operation.Error != nil && operation.Error.Message != nil {
+		return *operation.Error.Message
+	}
+
+	if operation.StatusDetails != nil {
+		return *operation.StatusDetails
+	}
+
+	return "unknown error"
+}
+
 func resourceKeyVaultCertificateRead(d *schema.ResourceData, meta interface{}) error {
 	keyVaultsClient := meta.(*clients.Client).KeyVault
 	client := meta.(*clients.Client).KeyVault.ManagementClient
@@ -572,6 +621,22 @@ func resourceKeyVaultCertificateRead(d *schema.ResourceData, meta interface{}) e
 	}
 	d.Set("thumbprint", thumbprint)
 
+	// the CSR is only available whilst the Certificate is pending (e.g. waiting to be merged when using the `Unknown` issuer)
+	certificateSigningRequest := ""
+	if cert.Cer == nil {
+		operation, err := client.GetCertificateOperation(ctx, id.KeyVaultBaseUrl, id.Name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(operation.Response) {
+				return fmt.Errorf("retrieving the pending operation for Key Vault Certificate %q: %+v", id.Name, err)
+			}
+		}
+
+		if operation.Status != nil && strings.EqualFold(*operation.Status, "inProgress") && operation.Csr != nil {
+			certificateSigningRequest = base64.StdEncoding.EncodeToString(*operation.Csr)
+		}
+	}
+	d.Set("certificate_signing_request", certificateSigningRequest)
+
 	return tags.FlattenAndSet(d, cert.Tags)
 }
 
@@ -769,7 +834,9 @@ func flattenKeyVaultCertificatePolicy(input *keyvault.CertificatePolicy, certDat
 
 	if params := input.IssuerParameters; params != nil {
 		issuerParams := make(map[string]interface{})
-		issuerParams["name"] = *params.Name
+		if params.Name != nil {
+			issuerParams["name"] = *params.Name
+		}
 		policy["issuer_parameters"] = []interface{}{issuerParams}
 	}
 
@@ -829,32 +896,7 @@ func flattenKeyVaultCertificatePolicy(input *keyvault.CertificatePolicy, certDat
 			usages = append(usages, string(usage))
 		}
 
-		sanOutputs := make([]interface{}, 0)
-		if san := props.SubjectAlternativeNames; san != nil {
-			sanOutput := make(map[string]interface{})
-			if emails := san.Emails; emails != nil {
-				sanOutput["emails"] = set.FromStringSlice(*emails)
-			}
-			if dnsNames := san.DNSNames; dnsNames != nil {
-				sanOutput["dns_names"] = set.FromStringSlice(*dnsNames)
-			}
-			if upns := san.Upns; upns != nil {
-				sanOutput["upns"] = set.FromStringSlice(*upns)
-			}
-
-			sanOutputs = append(sanOutputs, sanOutput)
-		} else if certData != nil && len(*certData) > 0 {
-			sanOutput := make(map[string]interface{})
-			cert, err := x509.ParseCertificate(*certData)
-			if err != nil {
-				log.Printf("[DEBUG] Unable to read certificate data: %v", err)
-			} else {
-				sanOutput["emails"] = set.FromStringSlice(cert.EmailAddresses)
-				sanOutput["dns_names"] = set.FromStringSlice(cert.DNSNames)
-				sanOutput["upns"] = set.FromStringSlice([]string{})
-				sanOutputs = append(sanOutputs, sanOutput)
-			}
-		}
+		sanOutputs := flattenKeyVaultCertificateSubjectAlternativeNames(props.SubjectAlternativeNames, certData)
 
 		certProps["key_usage"] = usages
 		certProps["subject"] = ""
@@ -863,7 +905,7 @@ func flattenKeyVaultCertificatePolicy(input *keyvault.CertificatePolicy, certDat
 		}
 		certProps["validity_in_months"] = int(*props.ValidityInMonths)
 		if props.Ekus != nil {
-			certProps["extended_key_usage"] = props.Ekus
+			certProps["extended_key_usage"] = utils.FlattenStringSlice(props.Ekus)
 		}
 		certProps["subject_alternative_names"] = sanOutputs
 		policy["x509_certificate_properties"] = []interface{}{certProps}
@@ -872,6 +914,106 @@ func flattenKeyVaultCertificatePolicy(input *keyvault.CertificatePolicy, certDat
 	return []interface{}{policy}
 }
 
+func flattenKeyVaultCertificateSubjectAlternativeNames(input *keyvault.SubjectAlternativeNames, certData *[]byte) []interface{} {
+	emails := make([]string, 0)
+	dnsNames := make([]string, 0)
+	upns := make([]string, 0)
+
+	if input != nil {
+		if input.Emails != nil {
+			emails = *input.Emails
+		}
+		if input.DNSNames != nil {
+			dnsNames = *input.DNSNames
+		}
+		if input.Upns != nil {
+			upns = *input.Upns
+		}
+	} else {
+		// the Subject Alternative Names aren't returned in the policy for imported certificates, so we fall back to
+		// the ones from the certificate itself
+		if certData == nil || len(*certData) == 0 {
+			return []interface{}{}
+		}
+
+		cert, err := x509.ParseCertificate(*certData)
+		if err != nil {
+			log.Printf("[DEBUG] Unable to read certificate data: %v", err)
+			return []interface{}{}
+		}
+
+		emails = cert.EmailAddresses
+		dnsNames = cert.DNSNames
+		upns = keyVaultCertificateUserPrincipalNames(cert)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"emails":    set.FromStringSlice(emails),
+			"dns_names": set.FromStringSlice(dnsNames),
+			"upns":      set.FromStringSlice(upns),
+		},
+	}
+}
+
+var (
+	keyVaultCertificateSubjectAlternativeNameOID = asn1.ObjectIdentifier{2, 5, 29, 17}
+	keyVaultCertificateUserPrincipalNameOID      = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 311, 20, 2, 3}
+)
+
+// keyVaultCertificateUserPrincipalNames returns the User Principal Names contained in the Subject Alternative Name
+// extension of the certificate - which are encoded as an `otherName` and as such aren't parsed by the `x509` package
+func keyVaultCertificateUserPrincipalNames(cert *x509.Certificate) []string {
+	upns := make([]string, 0)
+
+	for _, extension := range cert.Extensions {
+		if !extension.Id.Equal(keyVaultCertificateSubjectAlternativeNameOID) {
+			continue
+		}
+
+		var names asn1.RawValue
+		if _, err := asn1.Unmarshal(extension.Value, &names); err != nil || !names.IsCompound || names.Tag != asn1.TagSequence {
+			log.Printf("[DEBUG] Unable to parse the Subject Alternative Names of the certificate: %v", err)
+			continue
+		}
+
+		rest := names.Bytes
+		for len(rest) > 0 {
+			var name asn1.RawValue
+			var err error
+			if rest, err = asn1.Unmarshal(rest, &name); err != nil {
+				log.Printf("[DEBUG] Unable to parse a Subject Alternative Name of the certificate: %v", err)
+				break
+			}
+
+			// an `otherName` is `[0] IMPLICIT SEQUENCE { type-id OBJECT IDENTIFIER, value [0] EXPLICIT ANY }`
+			if name.Class != asn1.ClassContextSpecific || name.Tag != 0 {
+				continue
+			}
+
+			var otherName struct {
+				TypeID asn1.ObjectIdentifier
+				Value  asn1.RawValue
+			}
+			if _, err := asn1.UnmarshalWithParams(name.FullBytes, &otherName, "tag:0"); err != nil {
+				continue
+			}
+			if !otherName.TypeID.Equal(keyVaultCertificateUserPrincipalNameOID) {
+				continue
+			}
+
+			var upn string
+			if _, err := asn1.UnmarshalWithParams(otherName.Value.Bytes, &upn, "utf8"); err != nil {
+				continue
+			}
+
+			upns = append(upns, upn)
+		}
+	}
+
+	return upns
+}
+
 func flattenKeyVaultCertificateAttribute(input *keyvault.CertificateAttributes) []interface{} {
 	if input == nil {
 		return []interface{}{}
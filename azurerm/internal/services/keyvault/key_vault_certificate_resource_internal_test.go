@@ -0,0 +1,123 @@
+package keyvault
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"math/big"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/keyvault/2016-10-01/keyvault"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func TestFlattenKeyVaultCertificateSubjectAlternativeNamesFromPolicy(t *testing.T) {
+	actual := flattenKeyVaultCertificateSubjectAlternativeNames(&keyvault.SubjectAlternativeNames{
+		DNSNames: &[]string{"internal.contoso.com", "domain.hello.world"},
+		Upns:     &[]string{"john@doe.com"},
+	}, nil)
+
+	assertKeyVaultCertificateSubjectAlternativeNames(t, actual, []string{}, []string{"domain.hello.world", "internal.contoso.com"}, []string{"john@doe.com"})
+}
+
+func TestFlattenKeyVaultCertificateSubjectAlternativeNamesFromCertificate(t *testing.T) {
+	certData := testKeyVaultCertificateWithSubjectAlternativeNames(t)
+
+	actual := flattenKeyVaultCertificateSubjectAlternativeNames(nil, &certData)
+
+	assertKeyVaultCertificateSubjectAlternativeNames(t, actual, []string{"mary@stu.co.uk"}, []string{"domain.hello.world", "internal.contoso.com"}, []string{"john@doe.com"})
+}
+
+func TestFlattenKeyVaultCertificateSubjectAlternativeNamesEmpty(t *testing.T) {
+	if actual := flattenKeyVaultCertificateSubjectAlternativeNames(nil, nil); len(actual) != 0 {
+		t.Fatalf("expected no Subject Alternative Names but got: %+v", actual)
+	}
+
+	invalid := []byte("not a certificate")
+	if actual := flattenKeyVaultCertificateSubjectAlternativeNames(nil, &invalid); len(actual) != 0 {
+		t.Fatalf("expected no Subject Alternative Names but got: %+v", actual)
+	}
+}
+
+func assertKeyVaultCertificateSubjectAlternativeNames(t *testing.T, actual []interface{}, emails, dnsNames, upns []string) {
+	if len(actual) != 1 {
+		t.Fatalf("expected a single Subject Alternative Names block but got %d", len(actual))
+	}
+
+	san := actual[0].(map[string]interface{})
+	for key, expected := range map[string][]string{
+		"emails":    emails,
+		"dns_names": dnsNames,
+		"upns":      upns,
+	} {
+		values := make([]string, 0)
+		for _, v := range san[key].(*schema.Set).List() {
+			values = append(values, v.(string))
+		}
+		sort.Strings(values)
+
+		if !reflect.DeepEqual(values, expected) {
+			t.Fatalf("expected %q to be %+v but got %+v", key, expected, values)
+		}
+	}
+}
+
+// testKeyVaultCertificateWithSubjectAlternativeNames returns a self-signed certificate containing an email address,
+// two DNS names and a User Principal Name (encoded as an `otherName`) as Subject Alternative Names
+func testKeyVaultCertificateWithSubjectAlternativeNames(t *testing.T) []byte {
+	upn, err := asn1.MarshalWithParams("john@doe.com", "utf8")
+	if err != nil {
+		t.Fatalf("marshalling UPN: %+v", err)
+	}
+
+	otherName, err := asn1.MarshalWithParams(struct {
+		TypeID asn1.ObjectIdentifier
+		Value  asn1.RawValue
+	}{
+		TypeID: keyVaultCertificateUserPrincipalNameOID,
+		Value:  asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: upn},
+	}, "tag:0")
+	if err != nil {
+		t.Fatalf("marshalling otherName: %+v", err)
+	}
+
+	names, err := asn1.Marshal([]asn1.RawValue{
+		{Class: asn1.ClassContextSpecific, Tag: 1, Bytes: []byte("mary@stu.co.uk")},
+		{Class: asn1.ClassContextSpecific, Tag: 2, Bytes: []byte("internal.contoso.com")},
+		{Class: asn1.ClassContextSpecific, Tag: 2, Bytes: []byte("domain.hello.world")},
+		{FullBytes: otherName},
+	})
+	if err != nil {
+		t.Fatalf("marshalling Subject Alternative Names: %+v", err)
+	}
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("generating key: %+v", err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "hello-world"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtraExtensions: []pkix.Extension{
+			{
+				Id:    keyVaultCertificateSubjectAlternativeNameOID,
+				Value: names,
+			},
+		},
+	}
+
+	certData, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("creating certificate: %+v", err)
+	}
+
+	return certData
+}
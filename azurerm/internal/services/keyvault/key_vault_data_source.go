@@ -189,9 +189,9 @@ func dataSourceKeyVaultRead(d *schema.ResourceData, meta interface{}) error {
 
 	if props := resp.Properties; props != nil {
 		d.Set("tenant_id", props.TenantID.String())
-		d.Set("enabled_for_deployment", props.EnabledForDeployment)
-		d.Set("enabled_for_disk_encryption", props.EnabledForDiskEncryption)
-		d.Set("enabled_for_template_deployment", props.EnabledForTemplateDeployment)
+		for key, value := range flattenKeyVaultEnabledForFlags(props) {
+			d.Set(key, value)
+		}
 		d.Set("purge_protection_enabled", props.EnablePurgeProtection)
 		d.Set("vault_uri", props.VaultURI)
 
@@ -0,0 +1,289 @@
+package keyvault
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/keyvault/2016-10-01/keyvault"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	azValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/keyvault/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/keyvault/validate"
+	storageValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	azSchema "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceKeyVaultManagedStorageAccount() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKeyVaultManagedStorageAccountCreate,
+		Read:   resourceKeyVaultManagedStorageAccountRead,
+		Update: resourceKeyVaultManagedStorageAccountUpdate,
+		Delete: resourceKeyVaultManagedStorageAccountDelete,
+
+		Importer: azSchema.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := parse.ManagedStorageAccountID(id)
+			return err
+		}),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ManagedStorageAccountName,
+			},
+
+			"key_vault_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.VaultID,
+			},
+
+			"storage_account_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: storageValidate.StorageAccountID,
+			},
+
+			"storage_account_key": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"key1",
+					"key2",
+				}, false),
+			},
+
+			"regenerate_key_automatically": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"regeneration_period": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: azValidate.ISO8601Duration,
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func resourceKeyVaultManagedStorageAccountCreate(d *schema.ResourceData, meta interface{}) error {
+	keyVaultsClient := meta.(*clients.Client).KeyVault
+	client := meta.(*clients.Client).KeyVault.ManagementClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	keyVaultId, err := parse.VaultID(d.Get("key_vault_id").(string))
+	if err != nil {
+		return err
+	}
+
+	keyVaultBaseUri, err := keyVaultsClient.BaseUriForKeyVault(ctx, *keyVaultId)
+	if err != nil {
+		return fmt.Errorf("retrieving base uri for %s: %+v", *keyVaultId, err)
+	}
+
+	id := parse.NewManagedStorageAccountID(*keyVaultBaseUri, name)
+
+	existing, err := client.GetStorageAccount(ctx, id.KeyVaultBaseUrl, id.Name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if existing.ID != nil && *existing.ID != "" {
+		return tf.ImportAsExistsError("azurerm_key_vault_managed_storage_account", id.ID())
+	}
+
+	regenerateKeyAutomatically := d.Get("regenerate_key_automatically").(bool)
+	regenerationPeriod := d.Get("regeneration_period").(string)
+	if err := validateKeyVaultManagedStorageAccountRegeneration(regenerateKeyAutomatically, regenerationPeriod); err != nil {
+		return err
+	}
+
+	t := d.Get("tags").(map[string]interface{})
+	parameters := keyvault.StorageAccountCreateParameters{
+		ResourceID:        utils.String(d.Get("storage_account_id").(string)),
+		ActiveKeyName:     utils.String(d.Get("storage_account_key").(string)),
+		AutoRegenerateKey: utils.Bool(regenerateKeyAutomatically),
+		Tags:              tags.Expand(t),
+	}
+	if regenerationPeriod != "" {
+		parameters.RegenerationPeriod = utils.String(regenerationPeriod)
+	}
+
+	if _, err := client.SetStorageAccount(ctx, id.KeyVaultBaseUrl, id.Name, parameters); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceKeyVaultManagedStorageAccountRead(d, meta)
+}
+
+func resourceKeyVaultManagedStorageAccountUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).KeyVault.ManagementClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ManagedStorageAccountID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	regenerateKeyAutomatically := d.Get("regenerate_key_automatically").(bool)
+	regenerationPeriod := d.Get("regeneration_period").(string)
+	if err := validateKeyVaultManagedStorageAccountRegeneration(regenerateKeyAutomatically, regenerationPeriod); err != nil {
+		return err
+	}
+
+	t := d.Get("tags").(map[string]interface{})
+	parameters := keyvault.StorageAccountUpdateParameters{
+		ActiveKeyName:     utils.String(d.Get("storage_account_key").(string)),
+		AutoRegenerateKey: utils.Bool(regenerateKeyAutomatically),
+		Tags:              tags.Expand(t),
+	}
+	if regenerationPeriod != "" {
+		parameters.RegenerationPeriod = utils.String(regenerationPeriod)
+	}
+
+	if _, err := client.UpdateStorageAccount(ctx, id.KeyVaultBaseUrl, id.Name, parameters); err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	return resourceKeyVaultManagedStorageAccountRead(d, meta)
+}
+
+func resourceKeyVaultManagedStorageAccountRead(d *schema.ResourceData, meta interface{}) error {
+	keyVaultsClient := meta.(*clients.Client).KeyVault
+	client := meta.(*clients.Client).KeyVault.ManagementClient
+	resourcesClient := meta.(*clients.Client).Resource
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ManagedStorageAccountID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	keyVaultIdRaw, err := keyVaultsClient.KeyVaultIDFromBaseUrl(ctx, resourcesClient, id.KeyVaultBaseUrl)
+	if err != nil {
+		return fmt.Errorf("retrieving the Resource ID the Key Vault at URL %q: %s", id.KeyVaultBaseUrl, err)
+	}
+	if keyVaultIdRaw == nil {
+		log.Printf("[DEBUG] Unable to determine the Resource ID for the Key Vault at URL %q - removing from state!", id.KeyVaultBaseUrl)
+		d.SetId("")
+		return nil
+	}
+
+	keyVaultId, err := parse.VaultID(*keyVaultIdRaw)
+	if err != nil {
+		return err
+	}
+
+	ok, err := keyVaultsClient.Exists(ctx, *keyVaultId)
+	if err != nil {
+		return fmt.Errorf("checking if %s for %s exists: %v", *keyVaultId, *id, err)
+	}
+	if !ok {
+		log.Printf("[DEBUG] %s was not found - removing %s from state", *keyVaultId, *id)
+		d.SetId("")
+		return nil
+	}
+
+	resp, err := client.GetStorageAccount(ctx, id.KeyVaultBaseUrl, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("key_vault_id", keyVaultId.ID())
+	d.Set("storage_account_id", resp.ResourceID)
+	d.Set("storage_account_key", resp.ActiveKeyName)
+	d.Set("regenerate_key_automatically", resp.AutoRegenerateKey)
+	d.Set("regeneration_period", resp.RegenerationPeriod)
+
+	return tags.FlattenAndSet(d, resp.Tags)
+}
+
+func resourceKeyVaultManagedStorageAccountDelete(d *schema.ResourceData, meta interface{}) error {
+	keyVaultsClient := meta.(*clients.Client).KeyVault
+	client := meta.(*clients.Client).KeyVault.ManagementClient
+	resourcesClient := meta.(*clients.Client).Resource
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ManagedStorageAccountID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	keyVaultIdRaw, err := keyVaultsClient.KeyVaultIDFromBaseUrl(ctx, resourcesClient, id.KeyVaultBaseUrl)
+	if err != nil {
+		return fmt.Errorf("retrieving the Resource ID the Key Vault at URL %q: %s", id.KeyVaultBaseUrl, err)
+	}
+	if keyVaultIdRaw == nil {
+		return fmt.Errorf("Unable to determine the Resource ID for the Key Vault at URL %q", id.KeyVaultBaseUrl)
+	}
+
+	keyVaultId, err := parse.VaultID(*keyVaultIdRaw)
+	if err != nil {
+		return err
+	}
+
+	ok, err := keyVaultsClient.Exists(ctx, *keyVaultId)
+	if err != nil {
+		return fmt.Errorf("checking if %s for %s exists: %v", *keyVaultId, *id, err)
+	}
+	if !ok {
+		log.Printf("[DEBUG] %s was not found - removing %s from state", *keyVaultId, *id)
+		return nil
+	}
+
+	if resp, err := client.DeleteStorageAccount(ctx, id.KeyVaultBaseUrl, id.Name); err != nil {
+		if !utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("deleting %s: %+v", *id, err)
+		}
+	}
+
+	return nil
+}
+
+func validateKeyVaultManagedStorageAccountRegeneration(regenerateKeyAutomatically bool, regenerationPeriod string) error {
+	if regenerateKeyAutomatically && regenerationPeriod == "" {
+		return fmt.Errorf("`regeneration_period` must be specified when `regenerate_key_automatically` is set to `true`")
+	}
+
+	if !regenerateKeyAutomatically && regenerationPeriod != "" {
+		return fmt.Errorf("`regeneration_period` can only be specified when `regenerate_key_automatically` is set to `true`")
+	}
+
+	return nil
+}
@@ -0,0 +1,279 @@
+package keyvault
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/keyvault/2016-10-01/keyvault"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	azValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/keyvault/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/keyvault/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	azSchema "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+const (
+	// the keys within the SAS Definition parameters which are exposed as top-level fields
+	keyVaultSasDefinitionSasTypeParameter        = "sasType"
+	keyVaultSasDefinitionValidityPeriodParameter = "validityPeriod"
+)
+
+func resourceKeyVaultManagedStorageAccountSasTokenDefinition() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKeyVaultManagedStorageAccountSasTokenDefinitionCreate,
+		Read:   resourceKeyVaultManagedStorageAccountSasTokenDefinitionRead,
+		Update: resourceKeyVaultManagedStorageAccountSasTokenDefinitionUpdate,
+		Delete: resourceKeyVaultManagedStorageAccountSasTokenDefinitionDelete,
+
+		Importer: azSchema.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := parse.ManagedStorageAccountSasTokenDefinitionID(id)
+			return err
+		}),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ManagedStorageAccountSasTokenDefinitionName,
+			},
+
+			"managed_storage_account_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ManagedStorageAccountID,
+			},
+
+			"sas_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"account",
+					"service",
+				}, false),
+			},
+
+			"validity_period": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: azValidate.ISO8601Duration,
+			},
+
+			"parameters": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"tags": tags.Schema(),
+
+			"secret_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceKeyVaultManagedStorageAccountSasTokenDefinitionCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).KeyVault.ManagementClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	storageAccountId, err := parse.ManagedStorageAccountID(d.Get("managed_storage_account_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewManagedStorageAccountSasTokenDefinitionID(storageAccountId.KeyVaultBaseUrl, storageAccountId.Name, d.Get("name").(string))
+
+	existing, err := client.GetSasDefinition(ctx, id.KeyVaultBaseUrl, id.StorageAccountName, id.Name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if existing.ID != nil && *existing.ID != "" {
+		return tf.ImportAsExistsError("azurerm_key_vault_managed_storage_account_sas_token_definition", id.ID())
+	}
+
+	parameters, err := expandKeyVaultSasDefinitionParameters(d.Get("sas_type").(string), d.Get("validity_period").(string), d.Get("parameters").(map[string]interface{}))
+	if err != nil {
+		return err
+	}
+
+	t := d.Get("tags").(map[string]interface{})
+	input := keyvault.SasDefinitionCreateParameters{
+		Parameters: parameters,
+		Tags:       tags.Expand(t),
+	}
+	if _, err := client.SetSasDefinition(ctx, id.KeyVaultBaseUrl, id.StorageAccountName, id.Name, input); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceKeyVaultManagedStorageAccountSasTokenDefinitionRead(d, meta)
+}
+
+func resourceKeyVaultManagedStorageAccountSasTokenDefinitionUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).KeyVault.ManagementClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ManagedStorageAccountSasTokenDefinitionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	parameters, err := expandKeyVaultSasDefinitionParameters(d.Get("sas_type").(string), d.Get("validity_period").(string), d.Get("parameters").(map[string]interface{}))
+	if err != nil {
+		return err
+	}
+
+	t := d.Get("tags").(map[string]interface{})
+	input := keyvault.SasDefinitionUpdateParameters{
+		Parameters: parameters,
+		Tags:       tags.Expand(t),
+	}
+	if _, err := client.UpdateSasDefinition(ctx, id.KeyVaultBaseUrl, id.StorageAccountName, id.Name, input); err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	return resourceKeyVaultManagedStorageAccountSasTokenDefinitionRead(d, meta)
+}
+
+func resourceKeyVaultManagedStorageAccountSasTokenDefinitionRead(d *schema.ResourceData, meta interface{}) error {
+	keyVaultsClient := meta.(*clients.Client).KeyVault
+	client := meta.(*clients.Client).KeyVault.ManagementClient
+	resourcesClient := meta.(*clients.Client).Resource
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ManagedStorageAccountSasTokenDefinitionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	keyVaultIdRaw, err := keyVaultsClient.KeyVaultIDFromBaseUrl(ctx, resourcesClient, id.KeyVaultBaseUrl)
+	if err != nil {
+		return fmt.Errorf("retrieving the Resource ID the Key Vault at URL %q: %s", id.KeyVaultBaseUrl, err)
+	}
+	if keyVaultIdRaw == nil {
+		log.Printf("[DEBUG] Unable to determine the Resource ID for the Key Vault at URL %q - removing from state!", id.KeyVaultBaseUrl)
+		d.SetId("")
+		return nil
+	}
+
+	keyVaultId, err := parse.VaultID(*keyVaultIdRaw)
+	if err != nil {
+		return err
+	}
+
+	ok, err := keyVaultsClient.Exists(ctx, *keyVaultId)
+	if err != nil {
+		return fmt.Errorf("checking if %s for %s exists: %v", *keyVaultId, *id, err)
+	}
+	if !ok {
+		log.Printf("[DEBUG] %s was not found - removing %s from state", *keyVaultId, *id)
+		d.SetId("")
+		return nil
+	}
+
+	resp, err := client.GetSasDefinition(ctx, id.KeyVaultBaseUrl, id.StorageAccountName, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	sasType, validityPeriod, parameters := flattenKeyVaultSasDefinitionParameters(resp.Parameters)
+
+	d.Set("name", id.Name)
+	d.Set("managed_storage_account_id", parse.NewManagedStorageAccountID(id.KeyVaultBaseUrl, id.StorageAccountName).ID())
+	d.Set("sas_type", sasType)
+	d.Set("validity_period", validityPeriod)
+	if err := d.Set("parameters", parameters); err != nil {
+		return fmt.Errorf("setting `parameters`: %+v", err)
+	}
+	d.Set("secret_id", resp.SecretID)
+
+	return tags.FlattenAndSet(d, resp.Tags)
+}
+
+func resourceKeyVaultManagedStorageAccountSasTokenDefinitionDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).KeyVault.ManagementClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ManagedStorageAccountSasTokenDefinitionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if resp, err := client.DeleteSasDefinition(ctx, id.KeyVaultBaseUrl, id.StorageAccountName, id.Name); err != nil {
+		if !utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("deleting %s: %+v", *id, err)
+		}
+	}
+
+	return nil
+}
+
+func expandKeyVaultSasDefinitionParameters(sasType, validityPeriod string, input map[string]interface{}) (map[string]*string, error) {
+	output := make(map[string]*string)
+	for k, v := range input {
+		if k == keyVaultSasDefinitionSasTypeParameter || k == keyVaultSasDefinitionValidityPeriodParameter {
+			return nil, fmt.Errorf("`parameters` cannot contain %q - use the `sas_type` and `validity_period` fields instead", k)
+		}
+
+		output[k] = utils.String(v.(string))
+	}
+
+	output[keyVaultSasDefinitionSasTypeParameter] = utils.String(sasType)
+	output[keyVaultSasDefinitionValidityPeriodParameter] = utils.String(validityPeriod)
+
+	return output, nil
+}
+
+func flattenKeyVaultSasDefinitionParameters(input map[string]*string) (sasType string, validityPeriod string, parameters map[string]interface{}) {
+	parameters = make(map[string]interface{})
+
+	for k, v := range input {
+		if v == nil {
+			continue
+		}
+
+		switch k {
+		case keyVaultSasDefinitionSasTypeParameter:
+			sasType = *v
+		case keyVaultSasDefinitionValidityPeriodParameter:
+			validityPeriod = *v
+		default:
+			parameters[k] = *v
+		}
+	}
+
+	return sasType, validityPeriod, parameters
+}
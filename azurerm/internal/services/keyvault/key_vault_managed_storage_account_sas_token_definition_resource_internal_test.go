@@ -0,0 +1,48 @@
+package keyvault
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestExpandKeyVaultSasDefinitionParameters(t *testing.T) {
+	actual, err := expandKeyVaultSasDefinitionParameters("account", "PT1H", map[string]interface{}{
+		"signedServices": "b",
+	})
+	if err != nil {
+		t.Fatalf("expected no error but got: %+v", err)
+	}
+
+	if len(actual) != 3 || *actual["sasType"] != "account" || *actual["validityPeriod"] != "PT1H" || *actual["signedServices"] != "b" {
+		t.Fatalf("unexpected parameters: %+v", actual)
+	}
+
+	for _, key := range []string{"sasType", "validityPeriod"} {
+		if _, err := expandKeyVaultSasDefinitionParameters("account", "PT1H", map[string]interface{}{key: "value"}); err == nil {
+			t.Fatalf("expected an error when %q is specified within `parameters`", key)
+		}
+	}
+}
+
+func TestFlattenKeyVaultSasDefinitionParameters(t *testing.T) {
+	account := "account"
+	validityPeriod := "PT1H"
+	signedServices := "b"
+
+	sasType, period, parameters := flattenKeyVaultSasDefinitionParameters(map[string]*string{
+		"sasType":        &account,
+		"validityPeriod": &validityPeriod,
+		"signedServices": &signedServices,
+		"signedIp":       nil,
+	})
+
+	if sasType != "account" {
+		t.Fatalf("expected `sas_type` to be %q but got %q", "account", sasType)
+	}
+	if period != "PT1H" {
+		t.Fatalf("expected `validity_period` to be %q but got %q", "PT1H", period)
+	}
+	if expected := map[string]interface{}{"signedServices": "b"}; !reflect.DeepEqual(parameters, expected) {
+		t.Fatalf("expected `parameters` to be %+v but got %+v", expected, parameters)
+	}
+}
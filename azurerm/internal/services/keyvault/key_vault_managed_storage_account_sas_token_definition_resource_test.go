@@ -0,0 +1,124 @@
+package keyvault_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/keyvault/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type KeyVaultManagedStorageAccountSasTokenDefinitionResource struct {
+}
+
+func TestAccKeyVaultManagedStorageAccountSasTokenDefinition_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_managed_storage_account_sas_token_definition", "test")
+	r := KeyVaultManagedStorageAccountSasTokenDefinitionResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data, "PT1H"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("sas_type").HasValue("account"),
+				check.That(data.ResourceName).Key("validity_period").HasValue("PT1H"),
+				check.That(data.ResourceName).Key("secret_id").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccKeyVaultManagedStorageAccountSasTokenDefinition_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_managed_storage_account_sas_token_definition", "test")
+	r := KeyVaultManagedStorageAccountSasTokenDefinitionResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data, "PT1H"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccKeyVaultManagedStorageAccountSasTokenDefinition_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_managed_storage_account_sas_token_definition", "test")
+	r := KeyVaultManagedStorageAccountSasTokenDefinitionResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data, "PT1H"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data, "P1D"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("validity_period").HasValue("P1D"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (KeyVaultManagedStorageAccountSasTokenDefinitionResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	id, err := parse.ManagedStorageAccountSasTokenDefinitionID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.KeyVault.ManagementClient.GetSasDefinition(ctx, id.KeyVaultBaseUrl, id.StorageAccountName, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.ID != nil), nil
+}
+
+func (KeyVaultManagedStorageAccountSasTokenDefinitionResource) basic(data acceptance.TestData, validityPeriod string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_key_vault_managed_storage_account_sas_token_definition" "test" {
+  name                       = "acctestsasdef%s"
+  managed_storage_account_id = azurerm_key_vault_managed_storage_account.test.id
+  sas_type                   = "account"
+  validity_period            = "%s"
+
+  parameters = {
+    signedServices      = "b"
+    signedResourceTypes = "co"
+    signedPermissions   = "rl"
+  }
+}
+`, KeyVaultManagedStorageAccountResource{}.basic(data), data.RandomString, validityPeriod)
+}
+
+func (r KeyVaultManagedStorageAccountSasTokenDefinitionResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_key_vault_managed_storage_account_sas_token_definition" "import" {
+  name                       = azurerm_key_vault_managed_storage_account_sas_token_definition.test.name
+  managed_storage_account_id = azurerm_key_vault_managed_storage_account_sas_token_definition.test.managed_storage_account_id
+  sas_type                   = azurerm_key_vault_managed_storage_account_sas_token_definition.test.sas_type
+  validity_period            = azurerm_key_vault_managed_storage_account_sas_token_definition.test.validity_period
+  parameters                 = azurerm_key_vault_managed_storage_account_sas_token_definition.test.parameters
+}
+`, r.basic(data, "PT1H"))
+}
@@ -57,6 +57,8 @@ func resourceKeyVault() *schema.Resource {
 		},
 		SchemaVersion: 2,
 
+		CustomizeDiff: resourceKeyVaultCustomizeDiff,
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
 			Read:   schema.DefaultTimeout(5 * time.Minute),
@@ -244,6 +246,29 @@ func resourceKeyVault() *schema.Resource {
 	}
 }
 
+func resourceKeyVaultCustomizeDiff(d *schema.ResourceDiff, _ interface{}) error {
+	if d.NewValueKnown("network_acls") && (d.Id() == "" || d.HasChange("network_acls")) {
+		networkAcls := d.Get("network_acls").([]interface{})
+		if err := validateKeyVaultNetworkAcls(networkAcls); err != nil {
+			return err
+		}
+
+		// a CustomizeDiff can't return warnings in this version of the Plugin SDK, so these are logged instead
+		for _, warning := range keyVaultNetworkAclsWarnings(networkAcls) {
+			log.Printf("[WARN] Key Vault %q: %s", d.Get("name").(string), warning)
+		}
+	}
+
+	// `access_policy` is Computed (to allow for the `azurerm_key_vault_access_policy` resource), so it's only
+	// possible to tell that Access Policies have been configured when they're being set, or when RBAC
+	// Authorization is being enabled for a Key Vault which already has Access Policies
+	if d.Id() != "" && !d.HasChange("access_policy") && !d.HasChange("enable_rbac_authorization") {
+		return nil
+	}
+
+	return validateKeyVaultAccessPoliciesWithRbac(d.Get("enable_rbac_authorization").(bool), d.Get("access_policy").([]interface{}))
+}
+
 func resourceKeyVaultCreate(d *schema.ResourceData, meta interface{}) error {
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	client := meta.(*clients.Client).KeyVault.VaultsClient
@@ -635,9 +660,9 @@ func resourceKeyVaultRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("location", location.NormalizeNilable(resp.Location))
 
 	d.Set("tenant_id", props.TenantID.String())
-	d.Set("enabled_for_deployment", props.EnabledForDeployment)
-	d.Set("enabled_for_disk_encryption", props.EnabledForDiskEncryption)
-	d.Set("enabled_for_template_deployment", props.EnabledForTemplateDeployment)
+	for key, value := range flattenKeyVaultEnabledForFlags(&props) {
+		d.Set(key, value)
+	}
 	d.Set("enable_rbac_authorization", props.EnableRbacAuthorization)
 	d.Set("purge_protection_enabled", props.EnablePurgeProtection)
 	d.Set("vault_uri", props.VaultURI)
@@ -2,6 +2,8 @@ package keyvault
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"log"
 	"strings"
@@ -39,6 +41,8 @@ func resourceKeyVaultSecret() *schema.Resource {
 			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
+		CustomizeDiff: resourceKeyVaultSecretCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -55,9 +59,17 @@ func resourceKeyVaultSecret() *schema.Resource {
 			},
 
 			"value": {
-				Type:      schema.TypeString,
-				Required:  true,
-				Sensitive: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ExactlyOneOf: []string{"value", "source_secret_id"},
+			},
+
+			"source_secret_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: keyVaultValidate.NestedItemIdWithOptionalVersion,
+				ExactlyOneOf: []string{"value", "source_secret_id"},
 			},
 
 			"content_type": {
@@ -87,6 +99,12 @@ func resourceKeyVaultSecret() *schema.Resource {
 				Computed: true,
 			},
 
+			"value_hash": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
 			"tags": tags.Schema(),
 		},
 	}
@@ -123,6 +141,14 @@ func resourceKeyVaultSecretCreate(d *schema.ResourceData, meta interface{}) erro
 	}
 
 	value := d.Get("value").(string)
+	if sourceSecretId := d.Get("source_secret_id").(string); sourceSecretId != "" {
+		sourceValue, err := keyVaultSecretSourceValue(ctx, client, sourceSecretId)
+		if err != nil {
+			return err
+		}
+		value = *sourceValue
+	}
+
 	contentType := d.Get("content_type").(string)
 	t := d.Get("tags").(map[string]interface{})
 
@@ -227,7 +253,6 @@ func resourceKeyVaultSecretUpdate(d *schema.ResourceData, meta interface{}) erro
 		return nil
 	}
 
-	value := d.Get("value").(string)
 	contentType := d.Get("content_type").(string)
 	t := d.Get("tags").(map[string]interface{})
 
@@ -245,7 +270,16 @@ func resourceKeyVaultSecretUpdate(d *schema.ResourceData, meta interface{}) erro
 		secretAttributes.Expires = &expirationUnixTime
 	}
 
-	if d.HasChange("value") {
+	if d.HasChanges("value", "source_secret_id", "value_hash") {
+		value := d.Get("value").(string)
+		if sourceSecretId := d.Get("source_secret_id").(string); sourceSecretId != "" {
+			sourceValue, err := keyVaultSecretSourceValue(ctx, client, sourceSecretId)
+			if err != nil {
+				return err
+			}
+			value = *sourceValue
+		}
+
 		// for changing the value of the secret we need to create a new version
 		parameters := keyvault.SecretSetParameters{
 			Value:            utils.String(value),
@@ -339,7 +373,13 @@ func resourceKeyVaultSecretRead(d *schema.ResourceData, meta interface{}) error
 	}
 
 	d.Set("name", respID.Name)
-	d.Set("value", resp.Value)
+	// when the value is copied from another secret only its hash is stored, rather than the value itself
+	if d.Get("source_secret_id").(string) == "" {
+		d.Set("value", resp.Value)
+		d.Set("value_hash", "")
+	} else {
+		d.Set("value_hash", keyVaultSecretValueHash(resp.Value))
+	}
 	d.Set("version", respID.Version)
 	d.Set("content_type", resp.ContentType)
 	d.Set("versionless_id", fmt.Sprintf("%s/%s/%s", strings.TrimSuffix(id.KeyVaultBaseUrl, "/"), id.NestedItemType, id.Name))
@@ -405,6 +445,72 @@ func resourceKeyVaultSecretDelete(d *schema.ResourceData, meta interface{}) erro
 	return nil
 }
 
+func resourceKeyVaultSecretCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	if !d.NewValueKnown("source_secret_id") {
+		return d.SetNewComputed("value_hash")
+	}
+
+	// the hash is only tracked for secrets copied from another secret, since a `value` is diffed directly
+	sourceSecretId := d.Get("source_secret_id").(string)
+	if sourceSecretId == "" {
+		if d.Get("value_hash").(string) != "" {
+			return d.SetNew("value_hash", "")
+		}
+		return nil
+	}
+
+	// the value of the source secret can change without its ID changing, so compare the hashes to detect this
+	client := meta.(*clients.Client).KeyVault.ManagementClient
+	ctx, cancel := context.WithTimeout(meta.(*clients.Client).StopContext, 5*time.Minute)
+	defer cancel()
+
+	sourceValue, err := keyVaultSecretSourceValue(ctx, client, sourceSecretId)
+	if err != nil {
+		return err
+	}
+
+	if hash := keyVaultSecretValueHash(sourceValue); hash != d.Get("value_hash").(string) {
+		return d.SetNew("value_hash", hash)
+	}
+
+	return nil
+}
+
+func keyVaultSecretSourceValue(ctx context.Context, client *keyvault.BaseClient, sourceSecretId string) (*string, error) {
+	id, err := parse.ParseOptionallyVersionedNestedItemID(sourceSecretId)
+	if err != nil {
+		return nil, err
+	}
+
+	// an empty version indicates the latest version
+	resp, err := client.GetSecret(ctx, id.KeyVaultBaseUrl, id.Name, id.Version)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving source Secret %q (Key Vault %q): %+v", id.Name, id.KeyVaultBaseUrl, err)
+	}
+
+	if resp.Value == nil {
+		return nil, fmt.Errorf("retrieving source Secret %q (Key Vault %q): `value` was nil", id.Name, id.KeyVaultBaseUrl)
+	}
+
+	return resp.Value, nil
+}
+
+// keyVaultSecretValueHash returns an unsalted SHA256 hash of the value, which needs to be deterministic so that it can be
+// compared against the source Secret during the plan - this is stored in place of the value itself, which is what a
+// Secret configured using `value` stores in the state
+func keyVaultSecretValueHash(input *string) string {
+	if input == nil {
+		return ""
+	}
+
+	hash := sha256.Sum256([]byte(*input))
+	return hex.EncodeToString(hash[:])
+}
+
 var _ deleteAndPurgeNestedItem = deleteAndPurgeSecret{}
 
 type deleteAndPurgeSecret struct {
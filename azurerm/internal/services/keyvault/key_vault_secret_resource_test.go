@@ -3,6 +3,7 @@ package keyvault_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/Azure/azure-sdk-for-go/services/keyvault/2016-10-01/keyvault"
@@ -150,6 +151,45 @@ func TestAccKeyVaultSecret_updatingValueChangedExternally(t *testing.T) {
 	})
 }
 
+func TestAccKeyVaultSecret_sourceSecret(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_secret", "test")
+	r := KeyVaultSecretResource{}
+	var firstHash string
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.sourceSecret(data, "rick-and-morty"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("value").IsEmpty(),
+				check.That(data.ResourceName).Key("value_hash").MatchesRegex(regexp.MustCompile("^[0-9a-f]{64}$")),
+				check.That("azurerm_key_vault_secret.source").Key("value_hash").IsEmpty(),
+				data.CheckWithClient(r.valueMatchesSourceSecret),
+				func(state *terraform.State) error {
+					firstHash = state.RootModule().Resources[data.ResourceName].Primary.Attributes["value_hash"]
+					return nil
+				},
+			),
+		},
+		{
+			Config: r.sourceSecret(data, "szechuan"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("value").IsEmpty(),
+				check.That(data.ResourceName).Key("value_hash").MatchesRegex(regexp.MustCompile("^[0-9a-f]{64}$")),
+				check.That("azurerm_key_vault_secret.source").Key("value_hash").IsEmpty(),
+				data.CheckWithClient(r.valueMatchesSourceSecret),
+				func(state *terraform.State) error {
+					if hash := state.RootModule().Resources[data.ResourceName].Primary.Attributes["value_hash"]; hash == firstHash {
+						return fmt.Errorf("expected `value_hash` to change when the value of the source Secret changed but it was still %q", hash)
+					}
+					return nil
+				},
+			),
+		},
+	})
+}
+
 func TestAccKeyVaultSecret_recovery(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_key_vault_secret", "test")
 	r := KeyVaultSecretResource{}
@@ -250,6 +290,34 @@ func (KeyVaultSecretResource) Exists(ctx context.Context, clients *clients.Clien
 	return utils.Bool(resp.ID != nil), nil
 }
 
+func (KeyVaultSecretResource) valueMatchesSourceSecret(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) error {
+	client := clients.KeyVault.ManagementClient
+
+	id, err := parse.ParseNestedItemID(state.ID)
+	if err != nil {
+		return err
+	}
+	resp, err := client.GetSecret(ctx, id.KeyVaultBaseUrl, id.Name, "")
+	if err != nil {
+		return fmt.Errorf("retrieving Secret %q (Key Vault %q): %+v", id.Name, id.KeyVaultBaseUrl, err)
+	}
+
+	sourceId, err := parse.ParseOptionallyVersionedNestedItemID(state.Attributes["source_secret_id"])
+	if err != nil {
+		return err
+	}
+	sourceResp, err := client.GetSecret(ctx, sourceId.KeyVaultBaseUrl, sourceId.Name, sourceId.Version)
+	if err != nil {
+		return fmt.Errorf("retrieving source Secret %q (Key Vault %q): %+v", sourceId.Name, sourceId.KeyVaultBaseUrl, err)
+	}
+
+	if resp.Value == nil || sourceResp.Value == nil || *resp.Value != *sourceResp.Value {
+		return fmt.Errorf("expected the value of Secret %q to match the value of source Secret %q", id.Name, sourceId.Name)
+	}
+
+	return nil
+}
+
 func (KeyVaultSecretResource) Destroy(ctx context.Context, client *clients.Client, state *terraform.InstanceState) (*bool, error) {
 	dataPlaneClient := client.KeyVault.ManagementClient
 
@@ -395,6 +463,28 @@ resource "azurerm_key_vault_secret" "test" {
 `, r.template(data), data.RandomString)
 }
 
+func (r KeyVaultSecretResource) sourceSecret(data acceptance.TestData, value string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_key_vault_secret" "source" {
+  name         = "source-%s"
+  value        = "%s"
+  key_vault_id = azurerm_key_vault.test.id
+}
+
+resource "azurerm_key_vault_secret" "test" {
+  name             = "secret-%s"
+  source_secret_id = azurerm_key_vault_secret.source.id
+  key_vault_id     = azurerm_key_vault.test.id
+}
+`, r.template(data), data.RandomString, value, data.RandomString)
+}
+
 func (r KeyVaultSecretResource) softDeleteRecovery(data acceptance.TestData, purge bool) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -0,0 +1,106 @@
+package parse
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+type ManagedStorageAccountId struct {
+	KeyVaultBaseUrl string
+	Name            string
+}
+
+func NewManagedStorageAccountID(keyVaultBaseUrl, name string) ManagedStorageAccountId {
+	return ManagedStorageAccountId{
+		KeyVaultBaseUrl: keyVaultBaseUrl,
+		Name:            name,
+	}
+}
+
+func (id ManagedStorageAccountId) ID() string {
+	// example: https://example-keyvault.vault.azure.net/storage/exampleaccount
+	return fmt.Sprintf("%s/storage/%s", strings.TrimSuffix(id.KeyVaultBaseUrl, "/"), id.Name)
+}
+
+func (id ManagedStorageAccountId) String() string {
+	return fmt.Sprintf("Managed Storage Account %q (Key Vault %q)", id.Name, id.KeyVaultBaseUrl)
+}
+
+type ManagedStorageAccountSasTokenDefinitionId struct {
+	KeyVaultBaseUrl    string
+	StorageAccountName string
+	Name               string
+}
+
+func NewManagedStorageAccountSasTokenDefinitionID(keyVaultBaseUrl, storageAccountName, name string) ManagedStorageAccountSasTokenDefinitionId {
+	return ManagedStorageAccountSasTokenDefinitionId{
+		KeyVaultBaseUrl:    keyVaultBaseUrl,
+		StorageAccountName: storageAccountName,
+		Name:               name,
+	}
+}
+
+func (id ManagedStorageAccountSasTokenDefinitionId) ID() string {
+	// example: https://example-keyvault.vault.azure.net/storage/exampleaccount/sas/exampledefinition
+	return fmt.Sprintf("%s/storage/%s/sas/%s", strings.TrimSuffix(id.KeyVaultBaseUrl, "/"), id.StorageAccountName, id.Name)
+}
+
+func (id ManagedStorageAccountSasTokenDefinitionId) String() string {
+	return fmt.Sprintf("SAS Token Definition %q (Managed Storage Account %q / Key Vault %q)", id.Name, id.StorageAccountName, id.KeyVaultBaseUrl)
+}
+
+// ManagedStorageAccountID parses the ID of a Storage Account managed by Key Vault into a ManagedStorageAccountId object
+func ManagedStorageAccountID(input string) (*ManagedStorageAccountId, error) {
+	baseUrl, components, err := parseManagedStorageComponents(input)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(components) != 2 || components[0] != "storage" {
+		return nil, fmt.Errorf("Key Vault Managed Storage Account ID should be in the format `{keyVaultBaseUrl}/storage/{name}` but got %q", input)
+	}
+
+	return &ManagedStorageAccountId{
+		KeyVaultBaseUrl: baseUrl,
+		Name:            components[1],
+	}, nil
+}
+
+// ManagedStorageAccountSasTokenDefinitionID parses the ID of a SAS Token Definition within a Storage Account
+// managed by Key Vault into a ManagedStorageAccountSasTokenDefinitionId object
+func ManagedStorageAccountSasTokenDefinitionID(input string) (*ManagedStorageAccountSasTokenDefinitionId, error) {
+	baseUrl, components, err := parseManagedStorageComponents(input)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(components) != 4 || components[0] != "storage" || components[2] != "sas" {
+		return nil, fmt.Errorf("Key Vault Managed Storage Account SAS Token Definition ID should be in the format `{keyVaultBaseUrl}/storage/{storageAccountName}/sas/{name}` but got %q", input)
+	}
+
+	return &ManagedStorageAccountSasTokenDefinitionId{
+		KeyVaultBaseUrl:    baseUrl,
+		StorageAccountName: components[1],
+		Name:               components[3],
+	}, nil
+}
+
+func parseManagedStorageComponents(input string) (string, []string, error) {
+	idURL, err := url.ParseRequestURI(input)
+	if err != nil {
+		return "", nil, fmt.Errorf("Cannot parse Azure KeyVault Managed Storage Id: %s", err)
+	}
+
+	path := idURL.Path
+	path = strings.TrimPrefix(path, "/")
+	path = strings.TrimSuffix(path, "/")
+
+	// an explicit port (e.g. `:443`) is stripped so that the Base URL matches the `vault_uri` of the Key Vault
+	host := idURL.Host
+	if hostParts := strings.Split(host, ":"); len(hostParts) > 1 {
+		host = hostParts[0]
+	}
+
+	return fmt.Sprintf("%s://%s/", idURL.Scheme, host), strings.Split(path, "/"), nil
+}
@@ -0,0 +1,125 @@
+package parse
+
+import "testing"
+
+func TestManagedStorageAccountID(t *testing.T) {
+	cases := []struct {
+		Input       string
+		Expected    ManagedStorageAccountId
+		ExpectError bool
+	}{
+		{
+			Input:       "",
+			ExpectError: true,
+		},
+		{
+			Input:       "https://my-keyvault.vault.azure.net/storage",
+			ExpectError: true,
+		},
+		{
+			Input:       "https://my-keyvault.vault.azure.net/secrets/hello",
+			ExpectError: true,
+		},
+		{
+			Input:       "https://my-keyvault.vault.azure.net/storage/account1/sas/definition1",
+			ExpectError: true,
+		},
+		{
+			Input: "https://my-keyvault.vault.azure.net/storage/account1",
+			Expected: ManagedStorageAccountId{
+				KeyVaultBaseUrl: "https://my-keyvault.vault.azure.net/",
+				Name:            "account1",
+			},
+		},
+		{
+			Input: "https://my-keyvault.vault.azure.net:443/storage/account1/",
+			Expected: ManagedStorageAccountId{
+				KeyVaultBaseUrl: "https://my-keyvault.vault.azure.net/",
+				Name:            "account1",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing %q", tc.Input)
+
+		actual, err := ManagedStorageAccountID(tc.Input)
+		if err != nil {
+			if tc.ExpectError {
+				continue
+			}
+
+			t.Fatalf("Got error for ID %q: %+v", tc.Input, err)
+		}
+
+		if tc.ExpectError {
+			t.Fatalf("Expected an error but didn't get one for %q", tc.Input)
+		}
+
+		if *actual != tc.Expected {
+			t.Fatalf("Expected %+v but got %+v for %q", tc.Expected, *actual, tc.Input)
+		}
+
+		if expectedId := "https://my-keyvault.vault.azure.net/storage/account1"; actual.ID() != expectedId {
+			t.Fatalf("Expected the ID to be %q but got %q", expectedId, actual.ID())
+		}
+	}
+}
+
+func TestManagedStorageAccountSasTokenDefinitionID(t *testing.T) {
+	cases := []struct {
+		Input       string
+		Expected    ManagedStorageAccountSasTokenDefinitionId
+		ExpectError bool
+	}{
+		{
+			Input:       "",
+			ExpectError: true,
+		},
+		{
+			Input:       "https://my-keyvault.vault.azure.net/storage/account1",
+			ExpectError: true,
+		},
+		{
+			Input:       "https://my-keyvault.vault.azure.net/storage/account1/sas",
+			ExpectError: true,
+		},
+		{
+			Input:       "https://my-keyvault.vault.azure.net/storage/account1/keys/definition1",
+			ExpectError: true,
+		},
+		{
+			Input: "https://my-keyvault.vault.azure.net/storage/account1/sas/definition1",
+			Expected: ManagedStorageAccountSasTokenDefinitionId{
+				KeyVaultBaseUrl:    "https://my-keyvault.vault.azure.net/",
+				StorageAccountName: "account1",
+				Name:               "definition1",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing %q", tc.Input)
+
+		actual, err := ManagedStorageAccountSasTokenDefinitionID(tc.Input)
+		if err != nil {
+			if tc.ExpectError {
+				continue
+			}
+
+			t.Fatalf("Got error for ID %q: %+v", tc.Input, err)
+		}
+
+		if tc.ExpectError {
+			t.Fatalf("Expected an error but didn't get one for %q", tc.Input)
+		}
+
+		if *actual != tc.Expected {
+			t.Fatalf("Expected %+v but got %+v for %q", tc.Expected, *actual, tc.Input)
+		}
+
+		if actual.ID() != tc.Input {
+			t.Fatalf("Expected the ID to be %q but got %q", tc.Input, actual.ID())
+		}
+	}
+}
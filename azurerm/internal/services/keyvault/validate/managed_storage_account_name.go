@@ -0,0 +1,20 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+func ManagedStorageAccountName(v interface{}, k string) (warnings []string, errors []error) {
+	value := v.(string)
+
+	if matched := regexp.MustCompile(`^[0-9a-zA-Z]+$`).Match([]byte(value)); !matched {
+		errors = append(errors, fmt.Errorf("%q may only contain alphanumeric characters", k))
+	}
+
+	return warnings, errors
+}
+
+func ManagedStorageAccountSasTokenDefinitionName(v interface{}, k string) (warnings []string, errors []error) {
+	return ManagedStorageAccountName(v, k)
+}
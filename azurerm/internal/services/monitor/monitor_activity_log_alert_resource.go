@@ -151,6 +151,50 @@ func resourceMonitorActivityLogAlert() *schema.Resource {
 							Optional:      true,
 							ConflictsWith: []string{"criteria.0.recommendation_category", "criteria.0.recommendation_impact"},
 						},
+						"resource_health": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									// the Activity Log Alert API only supports a single `equals` value per field
+									"current": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringInSlice(monitorActivityLogAlertResourceHealthStatuses(), false),
+										},
+										Set: schema.HashString,
+									},
+									"previous": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringInSlice(monitorActivityLogAlertResourceHealthStatuses(), false),
+										},
+										Set: schema.HashString,
+									},
+									"reason": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+											ValidateFunc: validation.StringInSlice([]string{
+												"PlatformInitiated",
+												"UserInitiated",
+												"Unknown",
+											}, false),
+										},
+										Set: schema.HashString,
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -220,6 +264,10 @@ func resourceMonitorActivityLogAlertCreateUpdate(d *schema.ResourceData, meta in
 	criteriaRaw := d.Get("criteria").([]interface{})
 	actionRaw := d.Get("action").(*schema.Set).List()
 
+	if v := criteriaRaw[0].(map[string]interface{}); len(v["resource_health"].([]interface{})) > 0 && v["category"].(string) != "ResourceHealth" {
+		return fmt.Errorf("`resource_health` can only be specified when `category` is set to `ResourceHealth`")
+	}
+
 	t := d.Get("tags").(map[string]interface{})
 	expandedTags := tags.Expand(t)
 
@@ -397,11 +445,38 @@ func expandMonitorActivityLogAlertCriteria(input []interface{}) *insights.Activi
 		})
 	}
 
+	if resourceHealth := v["resource_health"].([]interface{}); len(resourceHealth) > 0 && resourceHealth[0] != nil {
+		conditions = append(conditions, expandMonitorActivityLogAlertResourceHealth(resourceHealth[0].(map[string]interface{}))...)
+	}
+
 	return &insights.ActivityLogAlertAllOfCondition{
 		AllOf: &conditions,
 	}
 }
 
+func expandMonitorActivityLogAlertResourceHealth(input map[string]interface{}) []insights.ActivityLogAlertLeafCondition {
+	conditions := make([]insights.ActivityLogAlertLeafCondition, 0)
+
+	fields := []struct {
+		key   string
+		field string
+	}{
+		{key: "current", field: "properties.currentHealthStatus"},
+		{key: "previous", field: "properties.previousHealthStatus"},
+		{key: "reason", field: "properties.cause"},
+	}
+	for _, f := range fields {
+		for _, value := range input[f.key].(*schema.Set).List() {
+			conditions = append(conditions, insights.ActivityLogAlertLeafCondition{
+				Field:  utils.String(f.field),
+				Equals: utils.String(value.(string)),
+			})
+		}
+	}
+
+	return conditions
+}
+
 func expandMonitorActivityLogAlertAction(input []interface{}) *insights.ActivityLogAlertActionList {
 	actions := make([]insights.ActivityLogAlertActionGroup, 0)
 	for _, item := range input {
@@ -430,9 +505,16 @@ func flattenMonitorActivityLogAlertCriteria(input *insights.ActivityLogAlertAllO
 	if input == nil || input.AllOf == nil {
 		return []interface{}{result}
 	}
+	resourceHealth := make(map[string]interface{})
 	for _, condition := range *input.AllOf {
 		if condition.Field != nil && condition.Equals != nil {
 			switch strings.ToLower(*condition.Field) {
+			case "properties.currenthealthstatus":
+				resourceHealth["current"] = []interface{}{*condition.Equals}
+			case "properties.previoushealthstatus":
+				resourceHealth["previous"] = []interface{}{*condition.Equals}
+			case "properties.cause":
+				resourceHealth["reason"] = []interface{}{*condition.Equals}
 			case "operationname":
 				result["operation_name"] = *condition.Equals
 			case "resourceprovider":
@@ -456,6 +538,9 @@ func flattenMonitorActivityLogAlertCriteria(input *insights.ActivityLogAlertAllO
 			}
 		}
 	}
+	if len(resourceHealth) > 0 {
+		result["resource_health"] = []interface{}{resourceHealth}
+	}
 	return []interface{}{result}
 }
 
@@ -484,6 +569,15 @@ func flattenMonitorActivityLogAlertAction(input *insights.ActivityLogAlertAction
 	return result
 }
 
+func monitorActivityLogAlertResourceHealthStatuses() []string {
+	return []string{
+		"Available",
+		"Degraded",
+		"Unavailable",
+		"Unknown",
+	}
+}
+
 func resourceMonitorActivityLogAlertActionHash(input interface{}) int {
 	var buf bytes.Buffer
 	if v, ok := input.(map[string]interface{}); ok {
@@ -160,6 +160,26 @@ func TestAccMonitorActivityLogAlert_basicAndCompleteUpdate(t *testing.T) {
 	})
 }
 
+func TestAccMonitorActivityLogAlert_resourceHealth(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_activity_log_alert", "test")
+	r := MonitorActivityLogAlertResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.resourceHealth(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("criteria.0.category").HasValue("ResourceHealth"),
+				check.That(data.ResourceName).Key("criteria.0.resource_health.#").HasValue("1"),
+				check.That(data.ResourceName).Key("criteria.0.resource_health.0.current.#").HasValue("1"),
+				check.That(data.ResourceName).Key("criteria.0.resource_health.0.previous.#").HasValue("1"),
+				check.That(data.ResourceName).Key("criteria.0.resource_health.0.reason.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (MonitorActivityLogAlertResource) basic(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -311,6 +331,35 @@ resource "azurerm_monitor_activity_log_alert" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, data.RandomString, data.RandomInteger)
 }
 
+func (MonitorActivityLogAlertResource) resourceHealth(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_monitor_activity_log_alert" "test" {
+  name                = "acctestActivityLogAlert-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  scopes              = [azurerm_resource_group.test.id]
+
+  criteria {
+    category = "ResourceHealth"
+
+    resource_health {
+      current  = ["Degraded"]
+      previous = ["Available"]
+      reason   = ["PlatformInitiated"]
+    }
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
+
 func (t MonitorActivityLogAlertResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
 	id, err := azure.ParseAzureResourceID(state.ID)
 	if err != nil {
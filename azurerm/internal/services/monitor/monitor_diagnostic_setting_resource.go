@@ -26,6 +26,8 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
+const monitorDiagnosticSettingRetentionPolicyDeprecationMessage = "Azure no longer supports retention policies on Diagnostic Settings - the retention of data exported to a Storage Account should instead be managed using the `azurerm_storage_management_policy` resource"
+
 func resourceMonitorDiagnosticSetting() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceMonitorDiagnosticSettingCreateUpdate,
@@ -43,6 +45,8 @@ func resourceMonitorDiagnosticSetting() *schema.Resource {
 			Delete: schema.DefaultTimeout(60 * time.Minute),
 		},
 
+		CustomizeDiff: resourceMonitorDiagnosticSettingCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -112,9 +116,10 @@ func resourceMonitorDiagnosticSetting() *schema.Resource {
 						},
 
 						"retention_policy": {
-							Type:     schema.TypeList,
-							Optional: true,
-							MaxItems: 1,
+							Type:       schema.TypeList,
+							Optional:   true,
+							MaxItems:   1,
+							Deprecated: monitorDiagnosticSettingRetentionPolicyDeprecationMessage,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"enabled": {
@@ -151,9 +156,10 @@ func resourceMonitorDiagnosticSetting() *schema.Resource {
 						},
 
 						"retention_policy": {
-							Type:     schema.TypeList,
-							Optional: true,
-							MaxItems: 1,
+							Type:       schema.TypeList,
+							Optional:   true,
+							MaxItems:   1,
+							Deprecated: monitorDiagnosticSettingRetentionPolicyDeprecationMessage,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"enabled": {
@@ -236,6 +242,12 @@ func resourceMonitorDiagnosticSettingCreateUpdate(d *schema.ResourceData, meta i
 		},
 	}
 
+	// Diagnostic Settings for a Subscription are routed to the Subscription Diagnostic Settings API, which
+	// only supports the Activity Log categories and rejects the `metrics` field outright
+	if monitorDiagnosticSettingTargetIsSubscription(actualResourceId) {
+		properties.DiagnosticSettings.Metrics = nil
+	}
+
 	valid = false
 	eventHubAuthorizationRuleId := d.Get("eventhub_authorization_rule_id").(string)
 	eventHubName := d.Get("eventhub_name").(string)
@@ -350,11 +362,13 @@ func resourceMonitorDiagnosticSettingRead(d *schema.ResourceData, meta interface
 
 	d.Set("log_analytics_destination_type", resp.LogAnalyticsDestinationType)
 
-	if err := d.Set("log", flattenMonitorDiagnosticLogs(resp.Logs)); err != nil {
+	logs := removeMonitorDiagnosticSettingEmptyRetentionPolicies(d.Get("log").(*schema.Set).List(), flattenMonitorDiagnosticLogs(resp.Logs))
+	if err := d.Set("log", logs); err != nil {
 		return fmt.Errorf("Error setting `log`: %+v", err)
 	}
 
-	if err := d.Set("metric", flattenMonitorDiagnosticMetrics(resp.Metrics)); err != nil {
+	metrics := removeMonitorDiagnosticSettingEmptyRetentionPolicies(d.Get("metric").(*schema.Set).List(), flattenMonitorDiagnosticMetrics(resp.Metrics))
+	if err := d.Set("metric", metrics); err != nil {
 		return fmt.Errorf("Error setting `metric`: %+v", err)
 	}
 
@@ -411,6 +425,96 @@ func monitorDiagnosticSettingDeletedRefreshFunc(ctx context.Context, client *ins
 	}
 }
 
+func resourceMonitorDiagnosticSettingCustomizeDiff(d *schema.ResourceDiff, _ interface{}) error {
+	for _, key := range []string{"log", "metric"} {
+		if !d.NewValueKnown(key) {
+			continue
+		}
+
+		if err := validateMonitorDiagnosticSettingRetentionPolicies(key, d.Get(key).(*schema.Set).List()); err != nil {
+			return err
+		}
+	}
+
+	if d.NewValueKnown("target_resource_id") && d.NewValueKnown("metric") {
+		if monitorDiagnosticSettingTargetIsSubscription(d.Get("target_resource_id").(string)) && d.Get("metric").(*schema.Set).Len() > 0 {
+			return fmt.Errorf("`metric` blocks cannot be specified when `target_resource_id` is a Subscription - only `log` blocks for the Activity Log categories are supported")
+		}
+	}
+
+	return nil
+}
+
+// monitorDiagnosticSettingTargetIsSubscription returns whether the specified Target Resource ID is a bare
+// Subscription ID (e.g. `/subscriptions/00000000-0000-0000-0000-000000000000`) rather than a Resource within it
+func monitorDiagnosticSettingTargetIsSubscription(input string) bool {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return false
+	}
+
+	return id.ResourceGroup == "" && id.Provider == "" && len(id.Path) == 0
+}
+
+func validateMonitorDiagnosticSettingRetentionPolicies(key string, input []interface{}) error {
+	for _, raw := range input {
+		v, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		policiesRaw, ok := v["retention_policy"].([]interface{})
+		if !ok || len(policiesRaw) == 0 || policiesRaw[0] == nil {
+			continue
+		}
+
+		policy := policiesRaw[0].(map[string]interface{})
+		if days := policy["days"].(int); days > 0 {
+			return fmt.Errorf("`%s.retention_policy.days` for the category %q must be `0` or omitted (got %d). %s", key, v["category"].(string), days, monitorDiagnosticSettingRetentionPolicyDeprecationMessage)
+		}
+	}
+
+	return nil
+}
+
+// removeMonitorDiagnosticSettingEmptyRetentionPolicies drops the (disabled, zero-day) Retention Policy which the API
+// returns for each category when the corresponding configured category omits the `retention_policy` block - since
+// Retention Policies are no longer supported this allows the block to be removed from the configuration without a diff
+func removeMonitorDiagnosticSettingEmptyRetentionPolicies(configured []interface{}, actual []interface{}) []interface{} {
+	withoutPolicy := make(map[string]bool)
+	for _, raw := range configured {
+		v, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		policies, _ := v["retention_policy"].([]interface{})
+		withoutPolicy[strings.ToLower(v["category"].(string))] = len(policies) == 0
+	}
+
+	for _, raw := range actual {
+		v := raw.(map[string]interface{})
+		category, _ := v["category"].(string)
+		if !withoutPolicy[strings.ToLower(category)] {
+			continue
+		}
+
+		policies, _ := v["retention_policy"].([]interface{})
+		if len(policies) == 0 {
+			continue
+		}
+
+		policy := policies[0].(map[string]interface{})
+		enabled, _ := policy["enabled"].(bool)
+		days, _ := policy["days"].(int)
+		if !enabled && days == 0 {
+			v["retention_policy"] = make([]interface{}, 0)
+		}
+	}
+
+	return actual
+}
+
 func expandMonitorDiagnosticsSettingsLogs(input []interface{}) []insights.LogSettings {
 	results := make([]insights.LogSettings, 0)
 
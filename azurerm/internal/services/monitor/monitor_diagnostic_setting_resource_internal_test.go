@@ -0,0 +1,177 @@
+package monitor
+
+import (
+	"testing"
+)
+
+func TestValidateMonitorDiagnosticSettingRetentionPolicies(t *testing.T) {
+	testData := []struct {
+		name     string
+		input    []interface{}
+		expected bool
+	}{
+		{
+			name:     "no categories",
+			input:    []interface{}{},
+			expected: true,
+		},
+		{
+			name: "no retention policy",
+			input: []interface{}{
+				map[string]interface{}{
+					"category":         "AuditEvent",
+					"retention_policy": []interface{}{},
+				},
+			},
+			expected: true,
+		},
+		{
+			name: "disabled retention policy",
+			input: []interface{}{
+				map[string]interface{}{
+					"category": "AuditEvent",
+					"retention_policy": []interface{}{
+						map[string]interface{}{
+							"enabled": false,
+							"days":    0,
+						},
+					},
+				},
+			},
+			expected: true,
+		},
+		{
+			name: "zero days",
+			input: []interface{}{
+				map[string]interface{}{
+					"category": "AuditEvent",
+					"retention_policy": []interface{}{
+						map[string]interface{}{
+							"enabled": true,
+							"days":    0,
+						},
+					},
+				},
+			},
+			expected: true,
+		},
+		{
+			name: "days greater than zero",
+			input: []interface{}{
+				map[string]interface{}{
+					"category": "AuditEvent",
+					"retention_policy": []interface{}{
+						map[string]interface{}{
+							"enabled": true,
+							"days":    7,
+						},
+					},
+				},
+			},
+			expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		err := validateMonitorDiagnosticSettingRetentionPolicies("log", v.input)
+		if valid := err == nil; valid != v.expected {
+			t.Fatalf("expected %t but got %t: %+v", v.expected, valid, err)
+		}
+	}
+}
+
+func TestRemoveMonitorDiagnosticSettingEmptyRetentionPolicies(t *testing.T) {
+	configured := []interface{}{
+		map[string]interface{}{
+			"category":         "AuditEvent",
+			"retention_policy": []interface{}{},
+		},
+		map[string]interface{}{
+			"category": "AllMetrics",
+			"retention_policy": []interface{}{
+				map[string]interface{}{
+					"enabled": false,
+					"days":    0,
+				},
+			},
+		},
+	}
+	actual := []interface{}{
+		map[string]interface{}{
+			"category": "auditevent",
+			"retention_policy": []interface{}{
+				map[string]interface{}{
+					"enabled": false,
+					"days":    0,
+				},
+			},
+		},
+		map[string]interface{}{
+			"category": "AllMetrics",
+			"retention_policy": []interface{}{
+				map[string]interface{}{
+					"enabled": false,
+					"days":    0,
+				},
+			},
+		},
+		map[string]interface{}{
+			"category": "Unconfigured",
+			"retention_policy": []interface{}{
+				map[string]interface{}{
+					"enabled": false,
+					"days":    0,
+				},
+			},
+		},
+	}
+
+	result := removeMonitorDiagnosticSettingEmptyRetentionPolicies(configured, actual)
+
+	expected := map[string]int{
+		"auditevent":   0,
+		"AllMetrics":   1,
+		"Unconfigured": 1,
+	}
+	for _, raw := range result {
+		v := raw.(map[string]interface{})
+		category := v["category"].(string)
+		if count := len(v["retention_policy"].([]interface{})); count != expected[category] {
+			t.Fatalf("expected %d retention policies for %q but got %d", expected[category], category, count)
+		}
+	}
+}
+
+func TestMonitorDiagnosticSettingTargetIsSubscription(t *testing.T) {
+	testData := []struct {
+		input    string
+		expected bool
+	}{
+		{
+			input:    "",
+			expected: false,
+		},
+		{
+			input:    "/subscriptions/00000000-0000-0000-0000-000000000000",
+			expected: true,
+		},
+		{
+			input:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1",
+			expected: false,
+		},
+		{
+			input:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.KeyVault/vaults/vault1",
+			expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.input)
+
+		if actual := monitorDiagnosticSettingTargetIsSubscription(v.input); actual != v.expected {
+			t.Fatalf("Expected %t but got %t for %q", v.expected, actual, v.input)
+		}
+	}
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/location"
@@ -42,6 +43,8 @@ func resourceMonitorMetricAlert() *schema.Resource {
 			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
+		CustomizeDiff: resourceMonitorMetricAlertCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -64,10 +67,11 @@ func resourceMonitorMetricAlert() *schema.Resource {
 			},
 
 			"target_resource_type": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Description: `The resource type (e.g. Microsoft.Compute/virtualMachines) of the target resource. Required when using subscription, resource group scope or multiple scopes.`,
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				DiffSuppressFunc: suppress.CaseDifference,
+				Description:      `The resource type (e.g. Microsoft.Compute/virtualMachines) of the target resource. Required when using subscription, resource group scope or multiple scopes.`,
 			},
 
 			"target_resource_location": {
@@ -578,6 +582,65 @@ func resourceMonitorMetricAlertDelete(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
+func resourceMonitorMetricAlertCustomizeDiff(d *schema.ResourceDiff, _ interface{}) error {
+	// the scopes can't be validated until they're known, e.g. when interpolated from another resource
+	if !d.NewValueKnown("scopes") {
+		return nil
+	}
+
+	// the Application Insights Web Test criteria targets both the Web Test and the Application Insights component
+	if len(d.Get("application_insights_web_test_location_availability_criteria").([]interface{})) != 0 {
+		return nil
+	}
+
+	// the target resource can't be validated until it's known, e.g. when interpolated from another resource - since
+	// both fields have a DiffSuppressFunc, when omitted from the config these are known (using the value from the state)
+	if !d.NewValueKnown("target_resource_type") || !d.NewValueKnown("target_resource_location") {
+		return nil
+	}
+
+	scopes := utils.ExpandStringSlice(d.Get("scopes").(*schema.Set).List())
+	return validateMonitorMetricAlertTargetResource(*scopes, d.Get("target_resource_type").(string), d.Get("target_resource_location").(string))
+}
+
+func validateMonitorMetricAlertTargetResource(scopes []string, targetResourceType, targetResourceLocation string) error {
+	if !monitorMetricAlertIsMultiResource(scopes) {
+		return nil
+	}
+
+	if targetResourceType == "" {
+		return fmt.Errorf("`target_resource_type` must be specified when `scopes` contains multiple resources, a Resource Group or a Subscription")
+	}
+
+	if targetResourceLocation == "" {
+		return fmt.Errorf("`target_resource_location` must be specified when `scopes` contains multiple resources, a Resource Group or a Subscription")
+	}
+
+	return nil
+}
+
+// monitorMetricAlertIsMultiResource returns whether the scopes require the Metric Alert to be a Multi-Resource alert,
+// which is the case when there's more than one scope or when the scope is a Resource Group or a Subscription
+func monitorMetricAlertIsMultiResource(scopes []string) bool {
+	if len(scopes) > 1 {
+		return true
+	}
+
+	for _, scope := range scopes {
+		id, err := azure.ParseAzureResourceID(scope)
+		if err != nil {
+			// the ID is validated by the schema
+			continue
+		}
+
+		if id.Provider == "" {
+			return true
+		}
+	}
+
+	return false
+}
+
 func expandMonitorMetricAlertCriteria(d *schema.ResourceData, isLegacy bool) (insights.BasicMetricAlertCriteria, error) {
 	switch {
 	case d.Get("criteria").(*schema.Set).Len() != 0:
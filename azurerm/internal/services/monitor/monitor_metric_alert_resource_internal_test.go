@@ -0,0 +1,189 @@
+package monitor
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestValidateMonitorMetricAlertTargetResource(t *testing.T) {
+	storageAccountId := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Storage/storageAccounts/account1"
+	otherStorageAccountId := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Storage/storageAccounts/account2"
+	resourceGroupId := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1"
+	subscriptionId := "/subscriptions/00000000-0000-0000-0000-000000000000"
+
+	testData := []struct {
+		name                   string
+		scopes                 []string
+		targetResourceType     string
+		targetResourceLocation string
+		valid                  bool
+	}{
+		{
+			name:   "single resource",
+			scopes: []string{storageAccountId},
+			valid:  true,
+		},
+		{
+			name:                   "single resource with target resource",
+			scopes:                 []string{storageAccountId},
+			targetResourceType:     "Microsoft.Storage/storageAccounts",
+			targetResourceLocation: "westeurope",
+			valid:                  true,
+		},
+		{
+			name:   "multiple resources without target resource",
+			scopes: []string{storageAccountId, otherStorageAccountId},
+			valid:  false,
+		},
+		{
+			name:               "multiple resources without target resource location",
+			scopes:             []string{storageAccountId, otherStorageAccountId},
+			targetResourceType: "Microsoft.Storage/storageAccounts",
+			valid:              false,
+		},
+		{
+			name:                   "multiple resources without target resource type",
+			scopes:                 []string{storageAccountId, otherStorageAccountId},
+			targetResourceLocation: "westeurope",
+			valid:                  false,
+		},
+		{
+			name:                   "multiple resources with target resource",
+			scopes:                 []string{storageAccountId, otherStorageAccountId},
+			targetResourceType:     "Microsoft.Storage/storageAccounts",
+			targetResourceLocation: "westeurope",
+			valid:                  true,
+		},
+		{
+			name:   "resource group without target resource",
+			scopes: []string{resourceGroupId},
+			valid:  false,
+		},
+		{
+			name:                   "resource group with target resource",
+			scopes:                 []string{resourceGroupId},
+			targetResourceType:     "Microsoft.Storage/storageAccounts",
+			targetResourceLocation: "westeurope",
+			valid:                  true,
+		},
+		{
+			name:   "subscription without target resource",
+			scopes: []string{subscriptionId},
+			valid:  false,
+		},
+		{
+			name:                   "subscription with target resource",
+			scopes:                 []string{subscriptionId},
+			targetResourceType:     "Microsoft.Storage/storageAccounts",
+			targetResourceLocation: "westeurope",
+			valid:                  true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		err := validateMonitorMetricAlertTargetResource(v.scopes, v.targetResourceType, v.targetResourceLocation)
+		if valid := err == nil; valid != v.valid {
+			t.Fatalf("expected valid to be %t but got %t: %+v", v.valid, valid, err)
+		}
+	}
+}
+
+// unknownVariableValue is the placeholder used by Terraform for values which aren't known until apply
+const unknownVariableValue = "74D93920-ED26-11E3-AC10-0800200C9A66"
+
+func TestMonitorMetricAlertCustomizeDiffTargetResource(t *testing.T) {
+	storageAccountId := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Storage/storageAccounts/account1"
+	otherStorageAccountId := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Storage/storageAccounts/account2"
+
+	testData := []struct {
+		name          string
+		config        map[string]interface{}
+		expectedError string
+	}{
+		{
+			name: "single resource with target resource omitted",
+			config: map[string]interface{}{
+				"scopes": []interface{}{storageAccountId},
+			},
+		},
+		{
+			name: "multiple resources with target resource omitted",
+			config: map[string]interface{}{
+				"scopes": []interface{}{storageAccountId, otherStorageAccountId},
+			},
+			expectedError: "`target_resource_type` must be specified",
+		},
+		{
+			name: "multiple resources with target resource location omitted",
+			config: map[string]interface{}{
+				"scopes":               []interface{}{storageAccountId, otherStorageAccountId},
+				"target_resource_type": "Microsoft.Storage/storageAccounts",
+			},
+			expectedError: "`target_resource_location` must be specified",
+		},
+		{
+			name: "multiple resources with target resource",
+			config: map[string]interface{}{
+				"scopes":                   []interface{}{storageAccountId, otherStorageAccountId},
+				"target_resource_type":     "Microsoft.Storage/storageAccounts",
+				"target_resource_location": "westeurope",
+			},
+		},
+		{
+			name: "multiple resources with unknown target resource location",
+			config: map[string]interface{}{
+				"scopes":                   []interface{}{storageAccountId, otherStorageAccountId},
+				"target_resource_type":     "Microsoft.Storage/storageAccounts",
+				"target_resource_location": unknownVariableValue,
+			},
+		},
+		{
+			name: "multiple resources with unknown target resource type",
+			config: map[string]interface{}{
+				"scopes":                   []interface{}{storageAccountId, otherStorageAccountId},
+				"target_resource_type":     unknownVariableValue,
+				"target_resource_location": "westeurope",
+			},
+		},
+		{
+			name: "multiple resources with empty target resource location",
+			config: map[string]interface{}{
+				"scopes":                   []interface{}{storageAccountId, otherStorageAccountId},
+				"target_resource_type":     "Microsoft.Storage/storageAccounts",
+				"target_resource_location": "",
+			},
+			expectedError: "`target_resource_location` must be specified",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		config := map[string]interface{}{
+			"name":                "alert1",
+			"resource_group_name": "group1",
+		}
+		for key, value := range v.config {
+			config[key] = value
+		}
+
+		_, err := resourceMonitorMetricAlert().Diff(&terraform.InstanceState{}, terraform.NewResourceConfigRaw(config), nil)
+		if v.expectedError == "" {
+			if err != nil {
+				t.Fatalf("expected no error for %q but got: %+v", v.name, err)
+			}
+			continue
+		}
+
+		if err == nil {
+			t.Fatalf("expected an error for %q but didn't get one", v.name)
+		}
+		if !strings.Contains(err.Error(), v.expectedError) {
+			t.Fatalf("expected the error for %q to contain %q but got: %+v", v.name, v.expectedError, err)
+		}
+	}
+}
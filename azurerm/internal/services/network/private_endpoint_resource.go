@@ -415,6 +415,13 @@ func resourcePrivateEndpointRead(d *schema.ResourceData, meta interface{}) error
 			}
 		}
 		flattenedConnection := flattenPrivateLinkEndpointServiceConnection(props.PrivateLinkServiceConnections, props.ManualPrivateLinkServiceConnections, privateIpAddress)
+		// the API doesn't guarantee the order (or casing) of the Group IDs, which would otherwise force a new resource
+		// when multiple `subresource_names` are specified
+		if len(flattenedConnection) > 0 {
+			connection := flattenedConnection[0].(map[string]interface{})
+			configured := d.Get("private_service_connection.0.subresource_names").([]interface{})
+			connection["subresource_names"] = alignPrivateEndpointSubresourceNames(configured, connection["subresource_names"].([]interface{}))
+		}
 		if err := d.Set("private_service_connection", flattenedConnection); err != nil {
 			return fmt.Errorf("setting `private_service_connection`: %+v", err)
 		}
@@ -521,6 +528,29 @@ func expandPrivateLinkEndpointServiceConnection(input []interface{}, parseManual
 	return &results
 }
 
+// alignPrivateEndpointSubresourceNames returns the configured subresource names when they contain the same
+// Group IDs as those returned from the API, otherwise the Group IDs returned from the API are used
+func alignPrivateEndpointSubresourceNames(configured []interface{}, actual []interface{}) []interface{} {
+	if len(configured) != len(actual) {
+		return actual
+	}
+
+	remaining := make(map[string]int)
+	for _, v := range actual {
+		remaining[strings.ToLower(v.(string))]++
+	}
+
+	for _, v := range configured {
+		key := strings.ToLower(v.(string))
+		if remaining[key] == 0 {
+			return actual
+		}
+		remaining[key]--
+	}
+
+	return configured
+}
+
 func flattenCustomDnsConfigs(customDnsConfigs *[]network.CustomDNSConfigPropertiesFormat) []interface{} {
 	results := make([]interface{}, 0)
 	if customDnsConfigs == nil {
@@ -0,0 +1,67 @@
+package network
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestAlignPrivateEndpointSubresourceNames(t *testing.T) {
+	testData := []struct {
+		name       string
+		configured []interface{}
+		actual     []interface{}
+		expected   []interface{}
+	}{
+		{
+			name:       "nothing configured",
+			configured: []interface{}{},
+			actual:     []interface{}{"blob"},
+			expected:   []interface{}{"blob"},
+		},
+		{
+			name:       "same order",
+			configured: []interface{}{"blob", "dfs"},
+			actual:     []interface{}{"blob", "dfs"},
+			expected:   []interface{}{"blob", "dfs"},
+		},
+		{
+			name:       "different order",
+			configured: []interface{}{"dfs", "blob"},
+			actual:     []interface{}{"blob", "dfs"},
+			expected:   []interface{}{"dfs", "blob"},
+		},
+		{
+			name:       "different casing",
+			configured: []interface{}{"Blob", "DFS"},
+			actual:     []interface{}{"dfs", "blob"},
+			expected:   []interface{}{"Blob", "DFS"},
+		},
+		{
+			name:       "different group ids",
+			configured: []interface{}{"blob", "dfs"},
+			actual:     []interface{}{"blob", "file"},
+			expected:   []interface{}{"blob", "file"},
+		},
+		{
+			name:       "duplicated group id",
+			configured: []interface{}{"blob", "blob"},
+			actual:     []interface{}{"blob", "dfs"},
+			expected:   []interface{}{"blob", "dfs"},
+		},
+		{
+			name:       "group id removed",
+			configured: []interface{}{"blob", "dfs"},
+			actual:     []interface{}{"blob"},
+			expected:   []interface{}{"blob"},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.name)
+
+		actual := alignPrivateEndpointSubresourceNames(v.configured, v.actual)
+		if !reflect.DeepEqual(actual, v.expected) {
+			t.Fatalf("Expected %+v but got %+v for %q", v.expected, actual, v.name)
+		}
+	}
+}
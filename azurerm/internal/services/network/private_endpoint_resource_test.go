@@ -205,6 +205,31 @@ func TestAccPrivateEndpoint_privateDnsZoneRemove(t *testing.T) {
 	})
 }
 
+func TestAccPrivateEndpoint_storageAccountBlobAndDfs(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_private_endpoint", "test")
+	r := PrivateEndpointResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.storageAccountBlobAndDfs(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("private_service_connection.0.subresource_names.#").HasValue("1"),
+				check.That(data.ResourceName).Key("private_service_connection.0.subresource_names.0").HasValue("blob"),
+				check.That("azurerm_private_endpoint.dfs").ExistsInAzure(r),
+				check.That("azurerm_private_endpoint.dfs").Key("private_service_connection.0.subresource_names.#").HasValue("1"),
+				check.That("azurerm_private_endpoint.dfs").Key("private_service_connection.0.subresource_names.0").HasValue("dfs"),
+			),
+		},
+		data.ImportStep(),
+		{
+			ResourceName:      "azurerm_private_endpoint.dfs",
+			ImportState:       true,
+			ImportStateVerify: true,
+		},
+	})
+}
+
 func (t PrivateEndpointResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
 	id, err := parse.PrivateEndpointID(state.ID)
 	if err != nil {
@@ -466,6 +491,73 @@ resource "azurerm_private_endpoint" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, data.RandomInteger, data.RandomInteger, data.RandomInteger, data.RandomInteger, data.RandomInteger)
 }
 
+func (PrivateEndpointResource) storageAccountBlobAndDfs(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-privatelink-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvnet-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  address_space       = ["10.5.0.0/16"]
+}
+
+resource "azurerm_subnet" "endpoint" {
+  name                 = "acctestsnetendpoint-%d"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefixes     = ["10.5.2.0/24"]
+
+  enforce_private_link_endpoint_network_policies = true
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_kind             = "StorageV2"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+  is_hns_enabled           = true
+}
+
+resource "azurerm_private_endpoint" "test" {
+  name                = "acctest-privatelink-blob-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  subnet_id           = azurerm_subnet.endpoint.id
+
+  private_service_connection {
+    name                           = "acctest-privatelink-psc-blob-%d"
+    private_connection_resource_id = azurerm_storage_account.test.id
+    subresource_names              = ["blob"]
+    is_manual_connection           = false
+  }
+}
+
+resource "azurerm_private_endpoint" "dfs" {
+  name                = "acctest-privatelink-dfs-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  subnet_id           = azurerm_subnet.endpoint.id
+
+  private_service_connection {
+    name                           = "acctest-privatelink-psc-dfs-%d"
+    private_connection_resource_id = azurerm_storage_account.test.id
+    subresource_names              = ["dfs"]
+    is_manual_connection           = false
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, data.RandomString, data.RandomInteger, data.RandomInteger, data.RandomInteger, data.RandomInteger)
+}
+
 func (PrivateEndpointResource) privateDnsZoneGroupRemove(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
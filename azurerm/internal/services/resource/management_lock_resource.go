@@ -83,7 +83,8 @@ func resourceManagementLockCreateUpdate(d *schema.ResourceData, meta interface{}
 			}
 		}
 
-		if existing.ID != nil && *existing.ID != "" {
+		// a lock inherited from a parent scope isn't managed by this resource, so shouldn't be imported
+		if existing.ID != nil && *existing.ID != "" && managementLockIsAppliedToScope(existing, scope) {
 			return tf.ImportAsExistsError("azurerm_management_lock", *existing.ID)
 		}
 	}
@@ -134,6 +135,12 @@ func resourceManagementLockRead(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("Error making Read request on AzureRM Management Lock %q (Scope %q): %+v", id.Name, id.Scope, err)
 	}
 
+	if !managementLockIsAppliedToScope(resp, id.Scope) {
+		log.Printf("[DEBUG] Management Lock %q is inherited from a parent of Scope %q rather than applied to it - removing from state", id.Name, id.Scope)
+		d.SetId("")
+		return nil
+	}
+
 	d.Set("name", resp.Name)
 	d.Set("scope", id.Scope)
 
@@ -187,6 +194,24 @@ func ParseAzureRMLockId(id string) (*AzureManagementLockId, error) {
 	return &lockId, nil
 }
 
+// managementLockIsAppliedToScope returns whether the lock is applied directly to the specified scope,
+// rather than being inherited from a parent scope. Since a false result removes the lock from the state,
+// this only returns false when the scope of the lock can be determined and clearly differs
+func managementLockIsAppliedToScope(lock locks.ManagementLockObject, scope string) bool {
+	if lock.ID == nil {
+		return true
+	}
+
+	// the casing of the ID returned by the API isn't guaranteed to match the casing it was created with
+	index := strings.LastIndex(strings.ToLower(*lock.ID), "/providers/microsoft.authorization/locks/")
+	if index == -1 {
+		return true
+	}
+
+	lockScope := (*lock.ID)[0:index]
+	return strings.EqualFold(strings.TrimSuffix(lockScope, "/"), strings.TrimSuffix(scope, "/"))
+}
+
 func validateManagementLockName(v interface{}, k string) (warnings []string, errors []error) {
 	input := v.(string)
 
@@ -0,0 +1,66 @@
+package resource
+
+import (
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2016-09-01/locks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestManagementLockIsAppliedToScope(t *testing.T) {
+	testCases := []struct {
+		id       *string
+		scope    string
+		expected bool
+	}{
+		{
+			// the scope can't be determined, so this is assumed to be applied to it
+			id:       nil,
+			scope:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1",
+			expected: true,
+		},
+		{
+			id:       utils.String("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/locks/lock1"),
+			scope:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1",
+			expected: true,
+		},
+		{
+			id:       utils.String("/SUBSCRIPTIONS/00000000-0000-0000-0000-000000000000/RESOURCEGROUPS/GROUP1/PROVIDERS/MICROSOFT.AUTHORIZATION/LOCKS/LOCK1"),
+			scope:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1",
+			expected: true,
+		},
+		{
+			id:       utils.String("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/microsoft.authorization/locks/lock1"),
+			scope:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/publicIPAddresses/ip1",
+			expected: false,
+		},
+		{
+			id:       utils.String("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Authorization/locks/lock1"),
+			scope:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1",
+			expected: true,
+		},
+		{
+			id:       utils.String("/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/GROUP1/providers/Microsoft.Authorization/locks/lock1"),
+			scope:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/",
+			expected: true,
+		},
+		{
+			// inherited from the Resource Group
+			id:       utils.String("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Authorization/locks/lock1"),
+			scope:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/publicIPAddresses/ip1",
+			expected: false,
+		},
+		{
+			id:       utils.String("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/publicIPAddresses/ip1/providers/Microsoft.Authorization/locks/lock1"),
+			scope:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/publicIPAddresses/ip1",
+			expected: true,
+		},
+	}
+
+	for _, test := range testCases {
+		actual := managementLockIsAppliedToScope(locks.ManagementLockObject{ID: test.id}, test.scope)
+		if actual != test.expected {
+			t.Fatalf("Expected %t for Scope %q but got %t", test.expected, test.scope, actual)
+		}
+	}
+}
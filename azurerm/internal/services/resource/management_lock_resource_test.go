@@ -123,6 +123,22 @@ func TestAccManagementLock_publicIPCanNotDeleteBasic(t *testing.T) {
 	})
 }
 
+func TestAccManagementLock_publicIPWithInheritedLock(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_management_lock", "test")
+	r := ManagementLockResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.publicIPWithInheritedLock(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("scope").MatchesOtherKey(check.That("azurerm_public_ip.test").Key("id")),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccManagementLock_subscriptionReadOnlyBasic(t *testing.T) {
 	_, exists := os.LookupEnv("TF_ACC_SUBSCRIPTION_PARALLEL_LOCK")
 	if !exists {
@@ -321,6 +337,41 @@ resource "azurerm_management_lock" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger)
 }
 
+func (ManagementLockResource) publicIPWithInheritedLock(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_public_ip" "test" {
+  name                    = "acctestpublicip-%d"
+  location                = azurerm_resource_group.test.location
+  resource_group_name     = azurerm_resource_group.test.name
+  allocation_method       = "Static"
+  idle_timeout_in_minutes = 30
+}
+
+resource "azurerm_management_lock" "parent" {
+  name       = "acctestlock-%d"
+  scope      = azurerm_resource_group.test.id
+  lock_level = "CanNotDelete"
+}
+
+resource "azurerm_management_lock" "test" {
+  name       = "acctestlock-%d"
+  scope      = azurerm_public_ip.test.id
+  lock_level = "CanNotDelete"
+
+  depends_on = [azurerm_management_lock.parent]
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, data.RandomInteger)
+}
+
 func (ManagementLockResource) subscriptionReadOnlyBasic(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -1,12 +1,18 @@
 package servicebus
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/servicebus/mgmt/2017-04-01/servicebus"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/servicebus/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func expandAuthorizationRuleRights(d *schema.ResourceData) *[]servicebus.AccessRights {
@@ -48,6 +54,27 @@ func flattenAuthorizationRuleRights(rights *[]servicebus.AccessRights) (listen,
 	return listen, send, manage
 }
 
+func flattenMessageCountDetails(input *servicebus.MessageCountDetails) (active, deadLetter, transfer int) {
+	// the counts are read-only and may not be returned, in which case they're zero
+	if input == nil {
+		return active, deadLetter, transfer
+	}
+
+	if input.ActiveMessageCount != nil {
+		active = int(*input.ActiveMessageCount)
+	}
+
+	if input.DeadLetterMessageCount != nil {
+		deadLetter = int(*input.DeadLetterMessageCount)
+	}
+
+	if input.TransferMessageCount != nil {
+		transfer = int(*input.TransferMessageCount)
+	}
+
+	return active, deadLetter, transfer
+}
+
 func authorizationRuleSchemaFrom(s map[string]*schema.Schema) map[string]*schema.Schema {
 	authSchema := map[string]*schema.Schema{
 		"listen": {
@@ -95,18 +122,138 @@ func authorizationRuleSchemaFrom(s map[string]*schema.Schema) map[string]*schema
 	return azure.MergeSchema(s, authSchema)
 }
 
+// authorizationRuleCustomizeDiff validates the rights of an Authorization Rule - which is shared between the Namespace,
+// Queue and Topic Authorization Rules since the same combinations of rights are supported at each scope
 func authorizationRuleCustomizeDiff(d *schema.ResourceDiff, _ interface{}) error {
-	listen, hasListen := d.GetOk("listen")
-	send, hasSend := d.GetOk("send")
-	manage, hasManage := d.GetOk("manage")
+	if !d.NewValueKnown("listen") || !d.NewValueKnown("send") || !d.NewValueKnown("manage") {
+		return nil
+	}
+
+	return validateAuthorizationRuleRights(d.Get("listen").(bool), d.Get("send").(bool), d.Get("manage").(bool))
+}
+
+func validateAuthorizationRuleRights(listen bool, send bool, manage bool) error {
+	if !listen && !send && !manage {
+		return fmt.Errorf("at least one of `listen`, `send` or `manage` must be set to `true`")
+	}
+
+	if manage && (!listen || !send) {
+		return fmt.Errorf("`listen` and `send` must both be set to `true` when `manage` is set to `true`")
+	}
+
+	return nil
+}
+
+// expressEntityCustomizeDiff validates the Express settings of a Queue or Topic, since Azure rejects Express Entities
+// which also require Duplicate Detection
+func expressEntityCustomizeDiff(d *schema.ResourceDiff, _ interface{}) error {
+	if !d.NewValueKnown("enable_express") || !d.NewValueKnown("requires_duplicate_detection") {
+		return nil
+	}
+
+	return validateExpressEntityDuplicateDetection(d.Get("enable_express").(bool), d.Get("requires_duplicate_detection").(bool))
+}
+
+func validateExpressEntityDuplicateDetection(enableExpress bool, requiresDuplicateDetection bool) error {
+	if enableExpress && requiresDuplicateDetection {
+		return fmt.Errorf("`enable_express` cannot be set to `true` when `requires_duplicate_detection` is set to `true` since Express Entities do not support Duplicate Detection")
+	}
+
+	return nil
+}
+
+// topicPartitioningCustomizeDiff validates the size of a partitioned Topic, which applies to each partition when the
+// Topic is within a Basic or Standard Namespace
+func topicPartitioningCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("enable_partitioning") || !d.NewValueKnown("max_size_in_megabytes") || !d.Get("enable_partitioning").(bool) {
+		return nil
+	}
+
+	if !d.NewValueKnown("namespace_name") || !d.NewValueKnown("resource_group_name") {
+		return nil
+	}
+
+	client := meta.(*clients.Client).ServiceBus.NamespacesClient
+	ctx, cancel := context.WithTimeout(meta.(*clients.Client).StopContext, 5*time.Minute)
+	defer cancel()
 
-	if !hasListen && !hasSend && !hasManage {
-		return fmt.Errorf("One of the `listen`, `send` or `manage` properties needs to be set")
+	namespaceName := d.Get("namespace_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	namespace, err := client.Get(ctx, resourceGroup, namespaceName)
+	if err != nil {
+		// the Namespace may be created in the same apply, in which case its SKU is validated by the API
+		if utils.ResponseWasNotFound(namespace.Response) {
+			return nil
+		}
+		return fmt.Errorf("retrieving ServiceBus Namespace %q (Resource Group %q): %+v", namespaceName, resourceGroup, err)
 	}
 
-	if manage.(bool) && (!listen.(bool) || !send.(bool)) {
-		return fmt.Errorf("if `manage` is set both `listen` and `send` must be set to true too")
+	if namespace.Sku == nil {
+		return nil
+	}
+
+	return validateTopicPartitioningLimits(namespace.Sku.Name, d.Get("enable_partitioning").(bool), d.Get("max_size_in_megabytes").(int))
+}
+
+const (
+	// partitioned entities in Basic and Standard namespaces are spread across 16 partitions, each of which is
+	// allocated `max_size_in_megabytes`
+	partitionedEntityPartitionCount = 16
+
+	// the size of each partition is limited to 5GB
+	partitionedEntityMaxSizeInMegabytes = 5120
+)
+
+func validateTopicPartitioningLimits(sku servicebus.SkuName, enablePartitioning bool, maxSizeInMegabytes int) error {
+	// the size of a partitioned entity in a Premium namespace isn't multiplied, so the usual limits apply
+	if !enablePartitioning || sku == servicebus.Premium {
+		return nil
+	}
+
+	if maxSizeInMegabytes > partitionedEntityMaxSizeInMegabytes {
+		return fmt.Errorf("`max_size_in_megabytes` must be at most %d when `enable_partitioning` is set to `true` in a %s Namespace since the size applies to each of the %d partitions (a total of %d megabytes) - got %d", partitionedEntityMaxSizeInMegabytes, string(sku), partitionedEntityPartitionCount, partitionedEntityMaxSizeInMegabytes*partitionedEntityPartitionCount, maxSizeInMegabytes)
 	}
 
 	return nil
 }
+
+// parseQueueImportID parses either a full Queue Resource ID or the shorthand `{resourceGroup}/{namespaceName}/{queueName}`,
+// which is assumed to be within the specified Subscription
+func parseQueueImportID(input string, subscriptionId string) (*parse.QueueId, error) {
+	if strings.HasPrefix(input, "/") {
+		return parse.QueueID(input)
+	}
+
+	segments := strings.Split(input, "/")
+	if len(segments) != 3 {
+		return nil, fmt.Errorf("expected a Queue Resource ID or an ID in the format `{resourceGroup}/{namespaceName}/{queueName}` but got %q", input)
+	}
+
+	for _, segment := range segments {
+		if segment == "" {
+			return nil, fmt.Errorf("expected a Queue Resource ID or an ID in the format `{resourceGroup}/{namespaceName}/{queueName}` but got %q", input)
+		}
+	}
+
+	id := parse.NewQueueID(subscriptionId, segments[0], segments[1], segments[2])
+	return &id, nil
+}
+
+// normalizeForwardTo returns the Entity Name from a forwarding destination, which Azure can return as
+// the full path to the entity (e.g. `sb://{namespace}.servicebus.windows.net/{entity}`) rather than the name
+func normalizeForwardTo(input string) string {
+	if i := strings.Index(input, "://"); i >= 0 {
+		input = input[i+3:]
+		if j := strings.Index(input, "/"); j >= 0 {
+			input = input[j+1:]
+		} else {
+			input = ""
+		}
+	}
+
+	return strings.Trim(input, "/")
+}
+
+func forwardToDiffSuppressFunc(_, old, new string, _ *schema.ResourceData) bool {
+	return strings.EqualFold(normalizeForwardTo(old), normalizeForwardTo(new))
+}
@@ -0,0 +1,437 @@
+package servicebus
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/servicebus/mgmt/2017-04-01/servicebus"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestFlattenMessageCountDetails(t *testing.T) {
+	tests := []struct {
+		name               string
+		input              *servicebus.MessageCountDetails
+		expectedActive     int
+		expectedDeadLetter int
+		expectedTransfer   int
+	}{
+		{
+			name:  "nil",
+			input: nil,
+		},
+		{
+			name:  "empty",
+			input: &servicebus.MessageCountDetails{},
+		},
+		{
+			name: "populated",
+			input: &servicebus.MessageCountDetails{
+				ActiveMessageCount:     utils.Int64(12),
+				DeadLetterMessageCount: utils.Int64(3),
+				ScheduledMessageCount:  utils.Int64(7),
+				TransferMessageCount:   utils.Int64(1),
+			},
+			expectedActive:     12,
+			expectedDeadLetter: 3,
+			expectedTransfer:   1,
+		},
+		{
+			name: "partially populated",
+			input: &servicebus.MessageCountDetails{
+				DeadLetterMessageCount: utils.Int64(5),
+			},
+			expectedDeadLetter: 5,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			active, deadLetter, transfer := flattenMessageCountDetails(test.input)
+			if active != test.expectedActive {
+				t.Fatalf("expected active message count to be %d but got %d", test.expectedActive, active)
+			}
+			if deadLetter != test.expectedDeadLetter {
+				t.Fatalf("expected dead letter message count to be %d but got %d", test.expectedDeadLetter, deadLetter)
+			}
+			if transfer != test.expectedTransfer {
+				t.Fatalf("expected transfer message count to be %d but got %d", test.expectedTransfer, transfer)
+			}
+		})
+	}
+}
+
+func TestParseQueueImportID(t *testing.T) {
+	subscriptionId := "12345678-1234-9876-4563-123456789012"
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ServiceBus/namespaces/namespace1/queues/queue1"
+
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+		error    bool
+	}{
+		{
+			name:     "resource id",
+			input:    expected,
+			expected: expected,
+		},
+		{
+			name:     "resource id with different casing",
+			input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourcegroups/resGroup1/providers/Microsoft.ServiceBus/namespaces/namespace1/queues/queue1",
+			expected: expected,
+		},
+		{
+			name:  "resource id missing queue",
+			input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ServiceBus/namespaces/namespace1",
+			error: true,
+		},
+		{
+			name:     "shorthand",
+			input:    "resGroup1/namespace1/queue1",
+			expected: expected,
+		},
+		{
+			name:  "shorthand missing queue",
+			input: "resGroup1/namespace1",
+			error: true,
+		},
+		{
+			name:  "shorthand with empty segment",
+			input: "resGroup1//queue1",
+			error: true,
+		},
+		{
+			name:  "shorthand with too many segments",
+			input: "resGroup1/namespace1/queue1/extra",
+			error: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			id, err := parseQueueImportID(test.input, subscriptionId)
+			if err != nil {
+				if test.error {
+					return
+				}
+
+				t.Fatalf("unexpected error: %+v", err)
+			}
+
+			if test.error {
+				t.Fatalf("expected an error but didn't get one")
+			}
+
+			if actual := id.ID(); actual != test.expected {
+				t.Fatalf("expected %q but got %q", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestNormalizeForwardTo(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{
+			input:    "",
+			expected: "",
+		},
+		{
+			input:    "queue1",
+			expected: "queue1",
+		},
+		{
+			input:    "sb://namespace1.servicebus.windows.net/queue1",
+			expected: "queue1",
+		},
+		{
+			input:    "https://namespace1.servicebus.windows.net/queue1/",
+			expected: "queue1",
+		},
+		{
+			input:    "https://namespace1.servicebus.windows.net/topic1/subscriptions/subscription1",
+			expected: "topic1/subscriptions/subscription1",
+		},
+		{
+			input:    "sb://namespace1.servicebus.windows.net",
+			expected: "",
+		},
+	}
+
+	for _, test := range tests {
+		if actual := normalizeForwardTo(test.input); actual != test.expected {
+			t.Fatalf("expected %q for %q but got %q", test.expected, test.input, actual)
+		}
+	}
+}
+
+func TestForwardToDiffSuppressFunc(t *testing.T) {
+	tests := []struct {
+		old      string
+		new      string
+		suppress bool
+	}{
+		{
+			old:      "queue1",
+			new:      "queue1",
+			suppress: true,
+		},
+		{
+			old:      "sb://namespace1.servicebus.windows.net/queue1",
+			new:      "queue1",
+			suppress: true,
+		},
+		{
+			old:      "queue1",
+			new:      "sb://namespace1.servicebus.windows.net/queue1",
+			suppress: true,
+		},
+		{
+			old:      "Queue1",
+			new:      "queue1",
+			suppress: true,
+		},
+		{
+			old:      "sb://namespace1.servicebus.windows.net/queue1",
+			new:      "queue2",
+			suppress: false,
+		},
+		{
+			old:      "",
+			new:      "queue1",
+			suppress: false,
+		},
+	}
+
+	for _, test := range tests {
+		if actual := forwardToDiffSuppressFunc("forward_to", test.old, test.new, nil); actual != test.suppress {
+			t.Fatalf("expected %t for %q -> %q but got %t", test.suppress, test.old, test.new, actual)
+		}
+	}
+}
+
+func TestComputeServiceBusSharedAccessSignature(t *testing.T) {
+	expiry := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	resourceUri := "https://namespace1.servicebus.windows.net/"
+	keyName := "RootManageSharedAccessKey"
+	key := "c2VjcmV0LWtleS12YWx1ZQ=="
+
+	tests := []struct {
+		name      string
+		urlEncode bool
+		expected  string
+	}{
+		{
+			name:      "url encoded",
+			urlEncode: true,
+			expected:  "SharedAccessSignature sr=https%3A%2F%2Fnamespace1.servicebus.windows.net%2F&sig=EKCPsmilOP%2Fyr9C%2BP533FvGHKVJF%2Bawr%2BBC7fv6HkD8%3D&se=1893456000&skn=RootManageSharedAccessKey",
+		},
+		{
+			name:      "not encoded",
+			urlEncode: false,
+			expected:  "SharedAccessSignature sr=https://namespace1.servicebus.windows.net/&sig=EKCPsmilOP/yr9C+P533FvGHKVJF+awr+BC7fv6HkD8=&se=1893456000&skn=RootManageSharedAccessKey",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual := computeServiceBusSharedAccessSignature(resourceUri, keyName, key, expiry, test.urlEncode)
+			if actual != test.expected {
+				t.Fatalf("expected %q but got %q", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestValidateExpressEntityDuplicateDetection(t *testing.T) {
+	tests := []struct {
+		name                       string
+		enableExpress              bool
+		requiresDuplicateDetection bool
+		expectError                bool
+	}{
+		{
+			name: "neither",
+		},
+		{
+			name:          "express only",
+			enableExpress: true,
+		},
+		{
+			name:                       "duplicate detection only",
+			requiresDuplicateDetection: true,
+		},
+		{
+			name:                       "express and duplicate detection",
+			enableExpress:              true,
+			requiresDuplicateDetection: true,
+			expectError:                true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := validateExpressEntityDuplicateDetection(test.enableExpress, test.requiresDuplicateDetection)
+			if test.expectError && err == nil {
+				t.Fatalf("expected an error but didn't get one")
+			}
+			if !test.expectError && err != nil {
+				t.Fatalf("expected no error but got: %+v", err)
+			}
+		})
+	}
+}
+
+func TestValidateTopicPartitioningLimits(t *testing.T) {
+	tests := []struct {
+		name               string
+		sku                servicebus.SkuName
+		enablePartitioning bool
+		maxSizeInMegabytes int
+		expectError        bool
+	}{
+		{
+			name:               "non-partitioned Standard at maximum size",
+			sku:                servicebus.Standard,
+			maxSizeInMegabytes: 5120,
+		},
+		{
+			name:               "non-partitioned Premium at maximum size",
+			sku:                servicebus.Premium,
+			maxSizeInMegabytes: 81920,
+		},
+		{
+			name:               "partitioned Standard at maximum size",
+			sku:                servicebus.Standard,
+			enablePartitioning: true,
+			maxSizeInMegabytes: 5120,
+		},
+		{
+			name:               "partitioned Basic above maximum size",
+			sku:                servicebus.Basic,
+			enablePartitioning: true,
+			maxSizeInMegabytes: 10240,
+			expectError:        true,
+		},
+		{
+			name:               "partitioned Standard at total size",
+			sku:                servicebus.Standard,
+			enablePartitioning: true,
+			maxSizeInMegabytes: 81920,
+			expectError:        true,
+		},
+		{
+			name:               "partitioned Premium at maximum size",
+			sku:                servicebus.Premium,
+			enablePartitioning: true,
+			maxSizeInMegabytes: 81920,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := validateTopicPartitioningLimits(test.sku, test.enablePartitioning, test.maxSizeInMegabytes)
+			if test.expectError && err == nil {
+				t.Fatalf("expected an error but didn't get one")
+			}
+			if !test.expectError && err != nil {
+				t.Fatalf("expected no error but got: %+v", err)
+			}
+		})
+	}
+}
+
+func TestAuthorizationRuleCustomizeDiff(t *testing.T) {
+	resources := map[string]*schema.Resource{
+		"namespace": resourceServiceBusNamespaceAuthorizationRule(),
+		"queue":     resourceServiceBusQueueAuthorizationRule(),
+		"topic":     resourceServiceBusTopicAuthorizationRule(),
+	}
+
+	tests := []struct {
+		name          string
+		listen        bool
+		send          bool
+		manage        bool
+		expectedError string
+	}{
+		{
+			name:          "no rights",
+			expectedError: "at least one of `listen`, `send` or `manage` must be set to `true`",
+		},
+		{
+			name:   "listen",
+			listen: true,
+		},
+		{
+			name: "send",
+			send: true,
+		},
+		{
+			name:   "listen and send",
+			listen: true,
+			send:   true,
+		},
+		{
+			name:          "manage only",
+			manage:        true,
+			expectedError: "`listen` and `send` must both be set to `true` when `manage` is set to `true`",
+		},
+		{
+			name:          "manage and listen",
+			listen:        true,
+			manage:        true,
+			expectedError: "`listen` and `send` must both be set to `true` when `manage` is set to `true`",
+		},
+		{
+			name:          "manage and send",
+			send:          true,
+			manage:        true,
+			expectedError: "`listen` and `send` must both be set to `true` when `manage` is set to `true`",
+		},
+		{
+			name:   "all rights",
+			listen: true,
+			send:   true,
+			manage: true,
+		},
+	}
+
+	for scope, resource := range resources {
+		for _, test := range tests {
+			t.Run(scope+"/"+test.name, func(t *testing.T) {
+				config := terraform.NewResourceConfigRaw(map[string]interface{}{
+					"name":                "rule",
+					"namespace_name":      "namespace",
+					"queue_name":          "queue",
+					"topic_name":          "topic",
+					"resource_group_name": "group",
+					"listen":              test.listen,
+					"send":                test.send,
+					"manage":              test.manage,
+				})
+
+				_, err := resource.Diff(&terraform.InstanceState{}, config, nil)
+				if test.expectedError == "" {
+					if err != nil {
+						t.Fatalf("expected no error but got: %+v", err)
+					}
+					return
+				}
+
+				if err == nil {
+					t.Fatalf("expected an error but didn't get one")
+				}
+				if !strings.Contains(err.Error(), test.expectedError) {
+					t.Fatalf("expected the error to contain %q but got: %+v", test.expectedError, err)
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,133 @@
+package servicebus
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/servicebus/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/servicebus/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+const (
+	serviceBusSasEncodingNone = "None"
+	serviceBusSasEncodingUrl  = "Url"
+)
+
+// This is a Shared Access Signature for a Namespace Authorization Rule:
+// https://docs.microsoft.com/en-us/azure/service-bus-messaging/service-bus-sas
+func dataSourceServiceBusNamespaceAuthorizationRuleSas() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceServiceBusNamespaceAuthorizationRuleSasRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"namespace_authorization_rule_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NamespaceAuthorizationRuleID,
+			},
+
+			"expiry": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+
+			"encoding": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  serviceBusSasEncodingUrl,
+				ValidateFunc: validation.StringInSlice([]string{
+					serviceBusSasEncodingNone,
+					serviceBusSasEncodingUrl,
+				}, false),
+			},
+
+			"sas": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"connection_string": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func dataSourceServiceBusNamespaceAuthorizationRuleSasRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).ServiceBus.NamespacesClient
+	endpointSuffix := meta.(*clients.Client).Account.Environment.ServiceBusEndpointSuffix
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.NamespaceAuthorizationRuleID(d.Get("namespace_authorization_rule_id").(string))
+	if err != nil {
+		return err
+	}
+
+	expiry, err := time.Parse(time.RFC3339, d.Get("expiry").(string))
+	if err != nil {
+		return fmt.Errorf("parsing `expiry`: %+v", err)
+	}
+
+	keys, err := client.ListKeys(ctx, id.ResourceGroup, id.NamespaceName, id.AuthorizationRuleName)
+	if err != nil {
+		if utils.ResponseWasNotFound(keys.Response) {
+			return fmt.Errorf("%s was not found", id)
+		}
+
+		return fmt.Errorf("listing keys for %s: %+v", id, err)
+	}
+
+	if keys.KeyName == nil || keys.PrimaryKey == nil {
+		return fmt.Errorf("listing keys for %s: `keyName` or `primaryKey` was nil", id)
+	}
+
+	endpoint := fmt.Sprintf("%s.%s", id.NamespaceName, endpointSuffix)
+	resourceUri := fmt.Sprintf("https://%s/", endpoint)
+	urlEncode := d.Get("encoding").(string) == serviceBusSasEncodingUrl
+	sasToken := computeServiceBusSharedAccessSignature(resourceUri, *keys.KeyName, *keys.PrimaryKey, expiry, urlEncode)
+
+	d.Set("sas", sasToken)
+	d.Set("connection_string", fmt.Sprintf("Endpoint=sb://%s/;SharedAccessSignature=%s", endpoint, sasToken))
+
+	tokenHash := sha256.Sum256([]byte(sasToken))
+	d.SetId(hex.EncodeToString(tokenHash[:]))
+
+	return nil
+}
+
+// computeServiceBusSharedAccessSignature signs the URL-encoded Resource URI and the Expiry (as a Unix timestamp)
+// using HMAC-SHA256 - the `sr` and `sig` values in the token are URL-encoded unless `urlEncode` is false
+func computeServiceBusSharedAccessSignature(resourceUri, keyName, key string, expiry time.Time, urlEncode bool) string {
+	encodedResourceUri := url.QueryEscape(resourceUri)
+	signedExpiry := strconv.FormatInt(expiry.Unix(), 10)
+
+	h := hmac.New(sha256.New, []byte(key))
+	h.Write([]byte(encodedResourceUri + "\n" + signedExpiry))
+	signature := base64.StdEncoding.EncodeToString(h.Sum(nil))
+
+	if urlEncode {
+		return fmt.Sprintf("SharedAccessSignature sr=%s&sig=%s&se=%s&skn=%s", encodedResourceUri, url.QueryEscape(signature), signedExpiry, keyName)
+	}
+
+	return fmt.Sprintf("SharedAccessSignature sr=%s&sig=%s&se=%s&skn=%s", resourceUri, signature, signedExpiry, keyName)
+}
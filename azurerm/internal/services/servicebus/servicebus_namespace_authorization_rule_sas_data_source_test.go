@@ -0,0 +1,40 @@
+package servicebus_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+)
+
+type ServiceBusNamespaceAuthorizationRuleSasDataSource struct {
+}
+
+func TestAccDataSourceServiceBusNamespaceAuthorizationRuleSas_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_servicebus_namespace_authorization_rule_sas", "test")
+	r := ServiceBusNamespaceAuthorizationRuleSasDataSource{}
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("sas").Exists(),
+				check.That(data.ResourceName).Key("connection_string").Exists(),
+				check.That(data.ResourceName).Key("encoding").HasValue("Url"),
+			),
+		},
+	})
+}
+
+func (ServiceBusNamespaceAuthorizationRuleSasDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_servicebus_namespace_authorization_rule_sas" "test" {
+  namespace_authorization_rule_id = azurerm_servicebus_namespace_authorization_rule.test.id
+  expiry                          = "2030-01-01T00:00:00Z"
+}
+`, ServiceBusNamespaceAuthorizationRuleResource{}.base(data, true, true, true))
+}
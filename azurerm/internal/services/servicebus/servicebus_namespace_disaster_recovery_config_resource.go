@@ -0,0 +1,344 @@
+package servicebus
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/servicebus/mgmt/2017-04-01/servicebus"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/servicebus/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/servicebus/validate"
+	azSchema "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceServiceBusNamespaceDisasterRecoveryConfig() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceServiceBusNamespaceDisasterRecoveryConfigCreate,
+		Read:   resourceServiceBusNamespaceDisasterRecoveryConfigRead,
+		Update: resourceServiceBusNamespaceDisasterRecoveryConfigUpdate,
+		Delete: resourceServiceBusNamespaceDisasterRecoveryConfigDelete,
+
+		Importer: azSchema.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := parse.NamespaceDisasterRecoveryConfigID(id)
+			return err
+		}),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		CustomizeDiff: resourceServiceBusNamespaceDisasterRecoveryConfigCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NamespaceName,
+			},
+
+			"namespace_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NamespaceName,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"partner_namespace_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NamespaceID,
+			},
+
+			"alternate_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NamespaceName,
+			},
+		},
+	}
+}
+
+func resourceServiceBusNamespaceDisasterRecoveryConfigCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).ServiceBus.DisasterRecoveryConfigsClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	log.Printf("[INFO] preparing arguments for ServiceBus Namespace Disaster Recovery Config creation.")
+
+	resourceId := parse.NewNamespaceDisasterRecoveryConfigID(subscriptionId, d.Get("resource_group_name").(string), d.Get("namespace_name").(string), d.Get("name").(string))
+
+	existing, err := client.Get(ctx, resourceId.ResourceGroup, resourceId.NamespaceName, resourceId.DisasterRecoveryConfigName)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for the presence of existing %s: %+v", resourceId, err)
+		}
+	}
+
+	if !utils.ResponseWasNotFound(existing.Response) {
+		return tf.ImportAsExistsError("azurerm_servicebus_namespace_disaster_recovery_config", resourceId.ID())
+	}
+
+	parameters := servicebus.ArmDisasterRecovery{
+		ArmDisasterRecoveryProperties: &servicebus.ArmDisasterRecoveryProperties{
+			PartnerNamespace: utils.String(d.Get("partner_namespace_id").(string)),
+		},
+	}
+
+	if v := d.Get("alternate_name").(string); v != "" {
+		parameters.ArmDisasterRecoveryProperties.AlternateName = utils.String(v)
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, resourceId.ResourceGroup, resourceId.NamespaceName, resourceId.DisasterRecoveryConfigName, parameters); err != nil {
+		return fmt.Errorf("creating %s: %+v", resourceId, err)
+	}
+
+	if err := resourceServiceBusNamespaceDisasterRecoveryConfigWaitForState(ctx, client, resourceId, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("waiting for replication to complete for %s: %+v", resourceId, err)
+	}
+
+	d.SetId(resourceId.ID())
+
+	return resourceServiceBusNamespaceDisasterRecoveryConfigRead(d, meta)
+}
+
+func resourceServiceBusNamespaceDisasterRecoveryConfigUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).ServiceBus.DisasterRecoveryConfigsClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.NamespaceDisasterRecoveryConfigID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("partner_namespace_id") {
+		// the pairing has to be broken before the Namespace can be paired with a different Namespace
+		breakPair, err := client.BreakPairing(ctx, id.ResourceGroup, id.NamespaceName, id.DisasterRecoveryConfigName)
+		if err != nil {
+			return fmt.Errorf("breaking the pairing for %s: %+v", *id, err)
+		}
+		if breakPair.StatusCode != http.StatusOK {
+			return fmt.Errorf("breaking the pairing for %s: unexpected status code %d", *id, breakPair.StatusCode)
+		}
+
+		if err := resourceServiceBusNamespaceDisasterRecoveryConfigWaitForState(ctx, client, *id, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("waiting for the pairing to be broken for %s: %+v", *id, err)
+		}
+	}
+
+	parameters := servicebus.ArmDisasterRecovery{
+		ArmDisasterRecoveryProperties: &servicebus.ArmDisasterRecoveryProperties{
+			PartnerNamespace: utils.String(d.Get("partner_namespace_id").(string)),
+		},
+	}
+
+	if v := d.Get("alternate_name").(string); v != "" {
+		parameters.ArmDisasterRecoveryProperties.AlternateName = utils.String(v)
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.NamespaceName, id.DisasterRecoveryConfigName, parameters); err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	if err := resourceServiceBusNamespaceDisasterRecoveryConfigWaitForState(ctx, client, *id, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return fmt.Errorf("waiting for replication to complete for %s: %+v", *id, err)
+	}
+
+	return resourceServiceBusNamespaceDisasterRecoveryConfigRead(d, meta)
+}
+
+func resourceServiceBusNamespaceDisasterRecoveryConfigRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).ServiceBus.DisasterRecoveryConfigsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.NamespaceDisasterRecoveryConfigID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.NamespaceName, id.DisasterRecoveryConfigName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.DisasterRecoveryConfigName)
+	d.Set("namespace_name", id.NamespaceName)
+	d.Set("resource_group_name", id.ResourceGroup)
+
+	if props := resp.ArmDisasterRecoveryProperties; props != nil {
+		d.Set("partner_namespace_id", props.PartnerNamespace)
+		d.Set("alternate_name", props.AlternateName)
+	}
+
+	return nil
+}
+
+func resourceServiceBusNamespaceDisasterRecoveryConfigDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).ServiceBus.DisasterRecoveryConfigsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.NamespaceDisasterRecoveryConfigID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	breakPair, err := client.BreakPairing(ctx, id.ResourceGroup, id.NamespaceName, id.DisasterRecoveryConfigName)
+	if err != nil {
+		return fmt.Errorf("breaking the pairing for %s: %+v", *id, err)
+	}
+	if breakPair.StatusCode != http.StatusOK {
+		return fmt.Errorf("breaking the pairing for %s: unexpected status code %d", *id, breakPair.StatusCode)
+	}
+
+	if err := resourceServiceBusNamespaceDisasterRecoveryConfigWaitForState(ctx, client, *id, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("waiting for the pairing to be broken for %s: %+v", *id, err)
+	}
+
+	if _, err := client.Delete(ctx, id.ResourceGroup, id.NamespaceName, id.DisasterRecoveryConfigName); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	// there's no future for the deletion, so poll until it's gone
+	deleteWait := &resource.StateChangeConf{
+		Pending:    []string{"200"},
+		Target:     []string{"404"},
+		MinTimeout: 30 * time.Second,
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Refresh: func() (interface{}, string, error) {
+			resp, err := client.Get(ctx, id.ResourceGroup, id.NamespaceName, id.DisasterRecoveryConfigName)
+			if err != nil {
+				if utils.ResponseWasNotFound(resp.Response) {
+					return resp, strconv.Itoa(resp.StatusCode), nil
+				}
+				return nil, "nil", fmt.Errorf("polling for the deletion of %s: %+v", *id, err)
+			}
+
+			return resp, strconv.Itoa(resp.StatusCode), nil
+		},
+	}
+
+	if _, err := deleteWait.WaitForState(); err != nil {
+		return fmt.Errorf("waiting for the deletion of %s: %+v", *id, err)
+	}
+
+	// it can take some time for the alias to become available again, which is required to re-create it
+	nameFreeWait := &resource.StateChangeConf{
+		Pending:    []string{string(servicebus.NameInUse)},
+		Target:     []string{string(servicebus.None)},
+		MinTimeout: 30 * time.Second,
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Refresh: func() (interface{}, string, error) {
+			resp, err := client.CheckNameAvailabilityMethod(ctx, id.ResourceGroup, id.NamespaceName, servicebus.CheckNameAvailability{Name: utils.String(id.DisasterRecoveryConfigName)})
+			if err != nil {
+				return resp, "Error", fmt.Errorf("checking if the name of %s has been freed: %+v", *id, err)
+			}
+
+			return resp, string(resp.Reason), nil
+		},
+	}
+
+	if _, err := nameFreeWait.WaitForState(); err != nil {
+		return fmt.Errorf("waiting for the name of %s to become available: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func resourceServiceBusNamespaceDisasterRecoveryConfigCustomizeDiff(d *schema.ResourceDiff, _ interface{}) error {
+	for _, key := range []string{"name", "namespace_name", "alternate_name"} {
+		if !d.NewValueKnown(key) {
+			return nil
+		}
+	}
+
+	return validateServiceBusNamespaceDisasterRecoveryConfigAlternateName(d.Get("name").(string), d.Get("namespace_name").(string), d.Get("alternate_name").(string))
+}
+
+// validateServiceBusNamespaceDisasterRecoveryConfigAlternateName validates the Alternate Name, which is required when
+// the Alias has the same name as the primary Namespace (e.g. when pairing Namespaces with the same name across regions)
+// and is only used in that case
+func validateServiceBusNamespaceDisasterRecoveryConfigAlternateName(name, namespaceName, alternateName string) error {
+	sameName := strings.EqualFold(name, namespaceName)
+
+	if sameName && alternateName == "" {
+		return fmt.Errorf("`alternate_name` must be specified when `name` is the same as `namespace_name`")
+	}
+
+	if !sameName && alternateName != "" {
+		return fmt.Errorf("`alternate_name` can only be specified when `name` is the same as `namespace_name`")
+	}
+
+	if alternateName != "" && strings.EqualFold(alternateName, name) {
+		return fmt.Errorf("`alternate_name` must be different to `name`")
+	}
+
+	return nil
+}
+
+func resourceServiceBusNamespaceDisasterRecoveryConfigWaitForState(ctx context.Context, client *servicebus.DisasterRecoveryConfigsClient, id parse.NamespaceDisasterRecoveryConfigId, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{string(servicebus.Accepted), "Replicating"},
+		Target:     []string{string(servicebus.Succeeded)},
+		MinTimeout: 30 * time.Second,
+		Timeout:    timeout,
+		Refresh: serviceBusNamespaceDisasterRecoveryConfigRefreshFunc(func() (servicebus.ArmDisasterRecovery, error) {
+			return client.Get(ctx, id.ResourceGroup, id.NamespaceName, id.DisasterRecoveryConfigName)
+		}, id),
+	}
+
+	_, err := stateConf.WaitForState()
+	return err
+}
+
+// serviceBusNamespaceDisasterRecoveryConfigRefreshFunc returns the provisioning state of the Disaster Recovery Config,
+// which is only considered `Succeeded` once there are no replication operations pending between the paired namespaces
+func serviceBusNamespaceDisasterRecoveryConfigRefreshFunc(get func() (servicebus.ArmDisasterRecovery, error), id parse.NamespaceDisasterRecoveryConfigId) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		read, err := get()
+		if err != nil {
+			return nil, "error", fmt.Errorf("retrieving %s: %+v", id, err)
+		}
+
+		if props := read.ArmDisasterRecoveryProperties; props != nil {
+			if props.ProvisioningState == servicebus.Failed {
+				return read, "failed", fmt.Errorf("replication for %s failed", id)
+			}
+
+			if props.ProvisioningState == servicebus.Succeeded && props.PendingReplicationOperationsCount != nil && *props.PendingReplicationOperationsCount > 0 {
+				log.Printf("[DEBUG] %d replication operations pending for %s", *props.PendingReplicationOperationsCount, id)
+				return read, "Replicating", nil
+			}
+
+			return read, string(props.ProvisioningState), nil
+		}
+
+		return read, "nil", fmt.Errorf("waiting for replication of %s: provisioning state is nil", id)
+	}
+}
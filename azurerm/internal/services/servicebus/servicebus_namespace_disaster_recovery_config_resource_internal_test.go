@@ -0,0 +1,121 @@
+package servicebus
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/servicebus/mgmt/2017-04-01/servicebus"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/servicebus/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestValidateServiceBusNamespaceDisasterRecoveryConfigAlternateName(t *testing.T) {
+	testData := []struct {
+		name          string
+		alias         string
+		namespaceName string
+		alternateName string
+		expectError   bool
+	}{
+		{
+			name:          "different names",
+			alias:         "acctest-alias",
+			namespaceName: "acctest-namespace",
+		},
+		{
+			name:          "different names with alternate name",
+			alias:         "acctest-alias",
+			namespaceName: "acctest-namespace",
+			alternateName: "acctest-alternate",
+			expectError:   true,
+		},
+		{
+			name:          "same names",
+			alias:         "acctest-namespace",
+			namespaceName: "acctest-namespace",
+			expectError:   true,
+		},
+		{
+			name:          "same names with alternate name",
+			alias:         "acctest-namespace",
+			namespaceName: "acctest-namespace",
+			alternateName: "acctest-alternate",
+		},
+		{
+			name:          "same names differing in casing with alternate name",
+			alias:         "acctest-namespace",
+			namespaceName: "ACCTEST-namespace",
+			alternateName: "acctest-alternate",
+		},
+		{
+			name:          "alternate name same as alias",
+			alias:         "acctest-namespace",
+			namespaceName: "acctest-namespace",
+			alternateName: "acctest-namespace",
+			expectError:   true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Run(v.name, func(t *testing.T) {
+			err := validateServiceBusNamespaceDisasterRecoveryConfigAlternateName(v.alias, v.namespaceName, v.alternateName)
+			if v.expectError && err == nil {
+				t.Fatalf("expected an error but didn't get one")
+			}
+			if !v.expectError && err != nil {
+				t.Fatalf("expected no error but got: %+v", err)
+			}
+		})
+	}
+}
+
+func TestServiceBusNamespaceDisasterRecoveryConfigRefreshFunc(t *testing.T) {
+	responses := []servicebus.ArmDisasterRecovery{
+		{
+			ArmDisasterRecoveryProperties: &servicebus.ArmDisasterRecoveryProperties{
+				ProvisioningState: servicebus.Accepted,
+			},
+		},
+		{
+			ArmDisasterRecoveryProperties: &servicebus.ArmDisasterRecoveryProperties{
+				ProvisioningState:                 servicebus.Succeeded,
+				PendingReplicationOperationsCount: utils.Int64(3),
+			},
+		},
+		{
+			ArmDisasterRecoveryProperties: &servicebus.ArmDisasterRecoveryProperties{
+				ProvisioningState:                 servicebus.Succeeded,
+				PendingReplicationOperationsCount: utils.Int64(0),
+			},
+		},
+	}
+
+	calls := 0
+	get := func() (servicebus.ArmDisasterRecovery, error) {
+		if calls >= len(responses) {
+			return servicebus.ArmDisasterRecovery{}, fmt.Errorf("unexpected call %d", calls)
+		}
+
+		resp := responses[calls]
+		calls++
+		return resp, nil
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{string(servicebus.Accepted), "Replicating"},
+		Target:       []string{string(servicebus.Succeeded)},
+		PollInterval: time.Millisecond,
+		Timeout:      time.Minute,
+		Refresh:      serviceBusNamespaceDisasterRecoveryConfigRefreshFunc(get, parse.NewNamespaceDisasterRecoveryConfigID("00000000-0000-0000-0000-000000000000", "group1", "namespace1", "alias1")),
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		t.Fatalf("unexpected error waiting for state: %+v", err)
+	}
+
+	if calls != len(responses) {
+		t.Fatalf("expected %d calls but got %d", len(responses), calls)
+	}
+}
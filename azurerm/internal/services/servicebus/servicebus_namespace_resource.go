@@ -8,6 +8,7 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/servicebus/mgmt/2018-01-01-preview/servicebus"
 	"github.com/hashicorp/go-azure-helpers/response"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
@@ -173,10 +174,52 @@ func resourceServiceBusNamespaceCreateUpdate(d *schema.ResourceData, meta interf
 		return fmt.Errorf("waiting for create/update of %s: %+v", resourceId, err)
 	}
 
+	// scaling a Premium namespace continues after the long-running operation has completed
+	if !d.IsNewResource() && d.HasChange("capacity") {
+		log.Printf("[DEBUG] Waiting for %s to finish scaling..", resourceId)
+		refresh := serviceBusNamespaceProvisioningStateRefreshFunc(func() (servicebus.SBNamespace, error) {
+			return client.Get(ctx, resourceId.ResourceGroup, resourceId.Name)
+		}, resourceId)
+		stateConf := serviceBusNamespaceProvisioningStateChangeConf(refresh, d.Timeout(schema.TimeoutUpdate))
+		if _, err := stateConf.WaitForState(); err != nil {
+			return fmt.Errorf("waiting for scaling of %s to complete: %+v", resourceId, err)
+		}
+	}
+
 	d.SetId(resourceId.ID())
 	return resourceServiceBusNamespaceRead(d, meta)
 }
 
+func serviceBusNamespaceProvisioningStateChangeConf(refresh resource.StateRefreshFunc, timeout time.Duration) *resource.StateChangeConf {
+	return &resource.StateChangeConf{
+		Pending:    []string{"Activating", "Created", "Creating", "Scaling", "Updating"},
+		Target:     []string{"Succeeded"},
+		Refresh:    refresh,
+		MinTimeout: 15 * time.Second,
+		Timeout:    timeout,
+	}
+}
+
+func serviceBusNamespaceProvisioningStateRefreshFunc(get func() (servicebus.SBNamespace, error), id parse.NamespaceId) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := get()
+		if err != nil {
+			return nil, "", fmt.Errorf("retrieving %s: %+v", id, err)
+		}
+
+		if resp.SBNamespaceProperties == nil || resp.SBNamespaceProperties.ProvisioningState == nil {
+			return nil, "", fmt.Errorf("retrieving %s: `properties.provisioningState` was nil", id)
+		}
+
+		provisioningState := *resp.SBNamespaceProperties.ProvisioningState
+		if strings.EqualFold(provisioningState, "Failed") {
+			return nil, "", fmt.Errorf("%s is in the provisioning state %q", id, provisioningState)
+		}
+
+		return resp, provisioningState, nil
+	}
+}
+
 func resourceServiceBusNamespaceRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).ServiceBus.NamespacesClientPreview
 	clientStable := meta.(*clients.Client).ServiceBus.NamespacesClient
@@ -0,0 +1,83 @@
+package servicebus
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/servicebus/mgmt/2018-01-01-preview/servicebus"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/servicebus/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestServiceBusNamespaceProvisioningStateRefreshFunc(t *testing.T) {
+	testData := []struct {
+		name        string
+		states      []string
+		expectError bool
+	}{
+		{
+			name:   "scaling completes",
+			states: []string{"Updating", "Updating", "Succeeded"},
+		},
+		{
+			name:   "already completed",
+			states: []string{"Succeeded"},
+		},
+		{
+			name:        "scaling fails",
+			states:      []string{"Updating", "Failed"},
+			expectError: true,
+		},
+		{
+			name:        "provisioning state missing",
+			states:      []string{"Updating", ""},
+			expectError: true,
+		},
+	}
+
+	id := parse.NewNamespaceID("00000000-0000-0000-0000-000000000000", "group1", "namespace1")
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		calls := 0
+		get := func() (servicebus.SBNamespace, error) {
+			if calls >= len(v.states) {
+				return servicebus.SBNamespace{}, fmt.Errorf("unexpected call %d", calls)
+			}
+
+			state := v.states[calls]
+			calls++
+
+			if state == "" {
+				return servicebus.SBNamespace{}, nil
+			}
+
+			return servicebus.SBNamespace{
+				SBNamespaceProperties: &servicebus.SBNamespaceProperties{
+					ProvisioningState: utils.String(state),
+				},
+			}, nil
+		}
+
+		stateConf := serviceBusNamespaceProvisioningStateChangeConf(serviceBusNamespaceProvisioningStateRefreshFunc(get, id), time.Minute)
+		stateConf.MinTimeout = 0
+		stateConf.PollInterval = time.Millisecond
+
+		_, err := stateConf.WaitForState()
+		if v.expectError {
+			if err == nil {
+				t.Fatalf("expected an error but didn't get one")
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+		if calls != len(v.states) {
+			t.Fatalf("expected %d calls but got %d", len(v.states), calls)
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package servicebus_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+)
+
+type ServiceBusQueueDataSource struct {
+}
+
+func TestAccDataSourceServiceBusQueue_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_servicebus_queue", "test")
+	r := ServiceBusQueueDataSource{}
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("max_size_in_megabytes").HasValue("1024"),
+				check.That(data.ResourceName).Key("lock_duration").HasValue("PT1M"),
+				check.That(data.ResourceName).Key("requires_session").HasValue("true"),
+				check.That(data.ResourceName).Key("dead_lettering_on_message_expiration").HasValue("true"),
+				check.That(data.ResourceName).Key("status").HasValue("Active"),
+			),
+		},
+	})
+}
+
+func (ServiceBusQueueDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_servicebus_namespace" "test" {
+  name                = "acctestservicebusnamespace-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "Standard"
+}
+
+resource "azurerm_servicebus_queue" "test" {
+  name                                 = "acctestservicebusqueue-%d"
+  resource_group_name                  = azurerm_resource_group.test.name
+  namespace_name                       = azurerm_servicebus_namespace.test.name
+  max_size_in_megabytes                = 1024
+  lock_duration                        = "PT1M"
+  requires_session                     = true
+  dead_lettering_on_message_expiration = true
+}
+
+data "azurerm_servicebus_queue" "test" {
+  name                = azurerm_servicebus_queue.test.name
+  resource_group_name = azurerm_resource_group.test.name
+  namespace_name      = azurerm_servicebus_namespace.test.name
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger)
+}
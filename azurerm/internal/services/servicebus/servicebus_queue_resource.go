@@ -14,7 +14,6 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/servicebus/parse"
 	azValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/servicebus/validate"
-	azSchema "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
@@ -26,10 +25,9 @@ func resourceServiceBusQueue() *schema.Resource {
 		Update: resourceServiceBusQueueCreateUpdate,
 		Delete: resourceServiceBusQueueDelete,
 
-		Importer: azSchema.ValidateResourceIDPriorToImport(func(id string) error {
-			_, err := parse.QueueID(id)
-			return err
-		}),
+		Importer: &schema.ResourceImporter{
+			State: resourceServiceBusQueueImport,
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
@@ -38,6 +36,8 @@ func resourceServiceBusQueue() *schema.Resource {
 			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
+		CustomizeDiff: expressEntityCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			// Required
 			"name": {
@@ -324,6 +324,19 @@ func resourceServiceBusQueueRead(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
+func resourceServiceBusQueueImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	log.Printf("[DEBUG] Importing Resource - parsing %q", d.Id())
+
+	id, err := parseQueueImportID(d.Id(), meta.(*clients.Client).Account.SubscriptionId)
+	if err != nil {
+		return []*schema.ResourceData{d}, fmt.Errorf("Error parsing Resource ID %q: %+v", d.Id(), err)
+	}
+
+	d.SetId(id.ID())
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceServiceBusQueueDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).ServiceBus.QueuesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
@@ -34,6 +34,26 @@ func TestAccServiceBusQueue_basic(t *testing.T) {
 	})
 }
 
+func TestAccServiceBusQueue_importShorthand(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_servicebus_queue", "test")
+	r := ServiceBusQueueResource{}
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			ResourceName:      data.ResourceName,
+			ImportState:       true,
+			ImportStateVerify: true,
+			ImportStateId:     fmt.Sprintf("acctestRG-%d/acctestservicebusnamespace-%d/acctestservicebusqueue-%d", data.RandomInteger, data.RandomInteger, data.RandomInteger),
+		},
+	})
+}
+
 func TestAccServiceBusQueue_requiresImport(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_servicebus_queue", "test")
 	r := ServiceBusQueueResource{}
@@ -175,6 +195,31 @@ func TestAccServiceBusQueue_enableDeadLetteringOnMessageExpiration(t *testing.T)
 	})
 }
 
+func TestAccServiceBusQueue_deadLetteringOnMessageExpirationDrift(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_servicebus_queue", "test")
+	r := ServiceBusQueueResource{}
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("dead_lettering_on_message_expiration").HasValue("false"),
+				data.CheckWithClient(r.enableDeadLetteringOnMessageExpirationOutOfBand),
+			),
+			// the out-of-band change should be detected when refreshing
+			ExpectNonEmptyPlan: true,
+		},
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("dead_lettering_on_message_expiration").HasValue("false"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccServiceBusQueue_lockDuration(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_servicebus_queue", "test")
 	r := ServiceBusQueueResource{}
@@ -356,6 +401,30 @@ func (t ServiceBusQueueResource) Exists(ctx context.Context, clients *clients.Cl
 	return utils.Bool(resp.ID != nil), nil
 }
 
+func (ServiceBusQueueResource) enableDeadLetteringOnMessageExpirationOutOfBand(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) error {
+	client := clients.ServiceBus.QueuesClient
+
+	id, err := parse.QueueID(state.ID)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.NamespaceName, id.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", id.String(), err)
+	}
+	if resp.SBQueueProperties == nil {
+		return fmt.Errorf("retrieving %s: `properties` was nil", id.String())
+	}
+
+	resp.SBQueueProperties.DeadLetteringOnMessageExpiration = utils.Bool(true)
+	if _, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.NamespaceName, id.Name, resp); err != nil {
+		return fmt.Errorf("updating %s: %+v", id.String(), err)
+	}
+
+	return nil
+}
+
 func (ServiceBusQueueResource) basic(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
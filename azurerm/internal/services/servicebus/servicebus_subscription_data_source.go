@@ -90,6 +90,21 @@ func dataSourceServiceBusSubscription() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+
+			"active_message_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"dead_letter_message_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"transfer_message_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -129,6 +144,11 @@ func dataSourceServiceBusSubscriptionRead(d *schema.ResourceData, meta interface
 		}
 
 		d.Set("max_delivery_count", maxDeliveryCount)
+
+		activeMessageCount, deadLetterMessageCount, transferMessageCount := flattenMessageCountDetails(props.CountDetails)
+		d.Set("active_message_count", activeMessageCount)
+		d.Set("dead_letter_message_count", deadLetterMessageCount)
+		d.Set("transfer_message_count", transferMessageCount)
 	}
 
 	return nil
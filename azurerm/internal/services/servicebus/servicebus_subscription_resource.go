@@ -108,13 +108,15 @@ func resourceServiceBusSubscription() *schema.Resource {
 			},
 
 			"forward_to": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: forwardToDiffSuppressFunc,
 			},
 
 			"forward_dead_lettered_messages_to": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: forwardToDiffSuppressFunc,
 			},
 
 			"status": {
@@ -127,6 +129,21 @@ func resourceServiceBusSubscription() *schema.Resource {
 					string(servicebus.ReceiveDisabled),
 				}, false),
 			},
+
+			"active_message_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"dead_letter_message_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"transfer_message_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -139,6 +156,10 @@ func resourceServiceBusSubscriptionCreateUpdate(d *schema.ResourceData, meta int
 	log.Printf("[INFO] preparing arguments for ServiceBus Subscription creation.")
 
 	resourceId := parse.NewSubscriptionID(subscriptionId, d.Get("resource_group_name").(string), d.Get("namespace_name").(string), d.Get("topic_name").(string), d.Get("name").(string))
+	if err := validate.SubscriptionEntityPath(resourceId.NamespaceName, resourceId.TopicName, resourceId.Name); err != nil {
+		return err
+	}
+
 	if d.IsNewResource() {
 		existing, err := client.Get(ctx, resourceId.ResourceGroup, resourceId.NamespaceName, resourceId.TopicName, resourceId.Name)
 		if err != nil {
@@ -230,6 +251,11 @@ func resourceServiceBusSubscriptionRead(d *schema.ResourceData, meta interface{}
 		if count := props.MaxDeliveryCount; count != nil {
 			d.Set("max_delivery_count", int(*count))
 		}
+
+		activeMessageCount, deadLetterMessageCount, transferMessageCount := flattenMessageCountDetails(props.CountDetails)
+		d.Set("active_message_count", activeMessageCount)
+		d.Set("dead_letter_message_count", deadLetterMessageCount)
+		d.Set("transfer_message_count", transferMessageCount)
 	}
 
 	return nil
@@ -129,6 +129,25 @@ func TestAccServiceBusSubscription_updateForwardTo(t *testing.T) {
 	})
 }
 
+func TestAccServiceBusSubscription_forwardToFullPath(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_servicebus_subscription", "test")
+	r := ServiceBusSubscriptionResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.forwardToFullPath(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			// specifying the bare entity name for the same destination shouldn't produce a diff
+			Config:   r.updateForwardTo(data),
+			PlanOnly: true,
+		},
+	})
+}
+
 func TestAccServiceBusSubscription_updateForwardDeadLetteredMessagesTo(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_servicebus_subscription", "test")
 	r := ServiceBusSubscriptionResource{}
@@ -298,6 +317,22 @@ resource "azurerm_servicebus_topic" "forward_to" {
 		"forward_to = \"${azurerm_servicebus_topic.forward_to.name}\"\n", data.RandomInteger)
 }
 
+func (ServiceBusSubscriptionResource) forwardToFullPath(data acceptance.TestData) string {
+	forwardToTf := testAccServiceBusSubscription_tfTemplate + `
+
+
+resource "azurerm_servicebus_topic" "forward_to" {
+  name                = "acctestservicebustopic-forward_to-%d"
+  namespace_name      = "${azurerm_servicebus_namespace.test.name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+
+`
+	return fmt.Sprintf(forwardToTf, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, data.RandomInteger,
+		"forward_to = \"sb://${azurerm_servicebus_namespace.test.name}.servicebus.windows.net/${azurerm_servicebus_topic.forward_to.name}\"\n", data.RandomInteger)
+}
+
 func (ServiceBusSubscriptionResource) updateForwardDeadLetteredMessagesTo(data acceptance.TestData) string {
 	forwardToTf := testAccServiceBusSubscription_tfTemplate + `
 
@@ -73,6 +73,8 @@ func resourceServiceBusSubscriptionRule() *schema.Resource {
 			"filter_type": {
 				Type:     schema.TypeString,
 				Required: true,
+				// the Filter Type of an existing Rule can't be changed in-place
+				ForceNew: true,
 				ValidateFunc: validation.StringInSlice([]string{
 					string(servicebus.FilterTypeSQLFilter),
 					string(servicebus.FilterTypeCorrelationFilter),
@@ -159,6 +161,10 @@ func resourceServiceBusSubscriptionRuleCreateUpdate(d *schema.ResourceData, meta
 		d.Get("topic_name").(string),
 		d.Get("subscription_name").(string),
 		d.Get("name").(string))
+	if err := validate.SubscriptionRuleEntityPath(resourceId.NamespaceName, resourceId.TopicName, resourceId.SubscriptionName, resourceId.RuleName); err != nil {
+		return err
+	}
+
 	if d.IsNewResource() {
 		existing, err := client.Get(ctx, resourceId.ResourceGroup, resourceId.NamespaceName, resourceId.TopicName, resourceId.SubscriptionName, resourceId.RuleName)
 		if err != nil {
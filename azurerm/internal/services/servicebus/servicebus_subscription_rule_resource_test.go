@@ -144,14 +144,53 @@ func TestAccServiceBusSubscriptionRule_updateSqlFilterToCorrelationFilter(t *tes
 			Config: r.basicSqlFilter(data),
 			Check: resource.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("filter_type").HasValue("SqlFilter"),
 			),
 		},
+		data.ImportStep(),
 		{
 			Config: r.basicCorrelationFilter(data),
 			Check: resource.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("filter_type").HasValue("CorrelationFilter"),
 			),
 		},
+		data.ImportStep(),
+		{
+			Config: r.basicSqlFilter(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("filter_type").HasValue("SqlFilter"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccServiceBusSubscriptionRule_multipleRules(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_servicebus_subscription_rule", "first")
+	r := ServiceBusSubscriptionRuleResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.multipleRules(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That("azurerm_servicebus_subscription_rule.second").ExistsInAzure(r),
+				check.That("azurerm_servicebus_subscription_rule.third").ExistsInAzure(r),
+				check.That(data.ResourceName).Key("sql_filter").HasValue("priority = 1"),
+				check.That("azurerm_servicebus_subscription_rule.second").Key("sql_filter").HasValue("priority = 2"),
+				check.That("azurerm_servicebus_subscription_rule.third").Key("correlation_filter.0.label").HasValue("priority-3"),
+			),
+		},
+		data.ImportStep(),
+		data.ImportStepFor("azurerm_servicebus_subscription_rule.second"),
+		data.ImportStepFor("azurerm_servicebus_subscription_rule.third"),
+		{
+			// re-applying the same configuration shouldn't reorder or otherwise change the Rules
+			Config:   r.multipleRules(data),
+			PlanOnly: true,
+		},
 	})
 }
 
@@ -328,6 +367,50 @@ resource "azurerm_servicebus_subscription_rule" "test" {
 `, r.template(data), data.RandomInteger)
 }
 
+func (r ServiceBusSubscriptionRuleResource) multipleRules(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_servicebus_subscription_rule" "first" {
+  name                = "acctestservicebusrule-first-%[2]d"
+  namespace_name      = azurerm_servicebus_namespace.test.name
+  topic_name          = azurerm_servicebus_topic.test.name
+  subscription_name   = azurerm_servicebus_subscription.test.name
+  resource_group_name = azurerm_resource_group.test.name
+  filter_type         = "SqlFilter"
+  sql_filter          = "priority = 1"
+}
+
+resource "azurerm_servicebus_subscription_rule" "second" {
+  name                = "acctestservicebusrule-second-%[2]d"
+  namespace_name      = azurerm_servicebus_namespace.test.name
+  topic_name          = azurerm_servicebus_topic.test.name
+  subscription_name   = azurerm_servicebus_subscription.test.name
+  resource_group_name = azurerm_resource_group.test.name
+  filter_type         = "SqlFilter"
+  sql_filter          = "priority = 2"
+  action              = "SET routed='second'"
+
+  depends_on = [azurerm_servicebus_subscription_rule.first]
+}
+
+resource "azurerm_servicebus_subscription_rule" "third" {
+  name                = "acctestservicebusrule-third-%[2]d"
+  namespace_name      = azurerm_servicebus_namespace.test.name
+  topic_name          = azurerm_servicebus_topic.test.name
+  subscription_name   = azurerm_servicebus_subscription.test.name
+  resource_group_name = azurerm_resource_group.test.name
+  filter_type         = "CorrelationFilter"
+
+  correlation_filter {
+    label = "priority-3"
+  }
+
+  depends_on = [azurerm_servicebus_subscription_rule.second]
+}
+`, r.template(data), data.RandomInteger)
+}
+
 func (ServiceBusSubscriptionRuleResource) template(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -0,0 +1,156 @@
+package servicebus
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/servicebus/mgmt/2017-04-01/servicebus"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/servicebus/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/servicebus/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceServiceBusTopic() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceServiceBusTopicRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.TopicName(),
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupNameForDataSource(),
+
+			"namespace_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NamespaceName,
+			},
+
+			"auto_delete_on_idle": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"default_message_ttl": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"duplicate_detection_history_time_window": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"enable_batched_operations": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"enable_express": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"enable_partitioning": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"max_size_in_megabytes": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"requires_duplicate_detection": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"subscription_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"support_ordering": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceServiceBusTopicRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).ServiceBus.TopicsClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := parse.NewTopicID(subscriptionId, d.Get("resource_group_name").(string), d.Get("namespace_name").(string), d.Get("name").(string))
+	resp, err := client.Get(ctx, id.ResourceGroup, id.NamespaceName, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("%s was not found", id)
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	if props := resp.SBTopicProperties; props != nil {
+		d.Set("auto_delete_on_idle", props.AutoDeleteOnIdle)
+		d.Set("default_message_ttl", props.DefaultMessageTimeToLive)
+		d.Set("duplicate_detection_history_time_window", props.DuplicateDetectionHistoryTimeWindow)
+		d.Set("enable_batched_operations", props.EnableBatchedOperations)
+		d.Set("enable_express", props.EnableExpress)
+		d.Set("enable_partitioning", props.EnablePartitioning)
+		d.Set("requires_duplicate_detection", props.RequiresDuplicateDetection)
+		d.Set("status", string(props.Status))
+		d.Set("support_ordering", props.SupportOrdering)
+
+		subscriptionCount := 0
+		if props.SubscriptionCount != nil {
+			subscriptionCount = int(*props.SubscriptionCount)
+		}
+		d.Set("subscription_count", subscriptionCount)
+
+		if apiMaxSizeInMegabytes := props.MaxSizeInMegabytes; apiMaxSizeInMegabytes != nil {
+			maxSizeInMegabytes := int(*apiMaxSizeInMegabytes)
+
+			// If the topic is NOT in a premium namespace (ie. it is Basic or Standard) and partitioning is enabled
+			// then the max size returned by the API will be 16 times greater than the value set.
+			if props.EnablePartitioning != nil && *props.EnablePartitioning {
+				namespacesClient := meta.(*clients.Client).ServiceBus.NamespacesClient
+				namespace, err := namespacesClient.Get(ctx, id.ResourceGroup, id.NamespaceName)
+				if err != nil {
+					return fmt.Errorf("retrieving ServiceBus Namespace %q (Resource Group %q): %+v", id.NamespaceName, id.ResourceGroup, err)
+				}
+
+				if namespace.Sku != nil && namespace.Sku.Name != servicebus.Premium {
+					const partitionCount = 16
+					maxSizeInMegabytes = int(*apiMaxSizeInMegabytes / partitionCount)
+				}
+			}
+
+			d.Set("max_size_in_megabytes", maxSizeInMegabytes)
+		}
+	}
+
+	return nil
+}
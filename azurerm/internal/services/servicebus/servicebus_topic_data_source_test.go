@@ -0,0 +1,85 @@
+package servicebus_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+)
+
+type ServiceBusTopicDataSource struct {
+}
+
+func TestAccDataSourceServiceBusTopic_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_servicebus_topic", "test")
+	r := ServiceBusTopicDataSource{}
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("max_size_in_megabytes").HasValue("2048"),
+				check.That(data.ResourceName).Key("requires_duplicate_detection").HasValue("true"),
+				check.That(data.ResourceName).Key("status").HasValue("Active"),
+				check.That(data.ResourceName).Key("subscription_count").HasValue("2"),
+			),
+		},
+	})
+}
+
+func (ServiceBusTopicDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_servicebus_namespace" "test" {
+  name                = "acctestservicebusnamespace-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "Standard"
+}
+
+resource "azurerm_servicebus_topic" "test" {
+  name                         = "acctestservicebustopic-%[1]d"
+  resource_group_name          = azurerm_resource_group.test.name
+  namespace_name               = azurerm_servicebus_namespace.test.name
+  max_size_in_megabytes        = 2048
+  requires_duplicate_detection = true
+}
+
+resource "azurerm_servicebus_subscription" "first" {
+  name                = "acctestsub-first-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  namespace_name      = azurerm_servicebus_namespace.test.name
+  topic_name          = azurerm_servicebus_topic.test.name
+  max_delivery_count  = 10
+}
+
+resource "azurerm_servicebus_subscription" "second" {
+  name                = "acctestsub-second-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  namespace_name      = azurerm_servicebus_namespace.test.name
+  topic_name          = azurerm_servicebus_topic.test.name
+  max_delivery_count  = 10
+}
+
+data "azurerm_servicebus_topic" "test" {
+  name                = azurerm_servicebus_topic.test.name
+  resource_group_name = azurerm_resource_group.test.name
+  namespace_name      = azurerm_servicebus_namespace.test.name
+
+  depends_on = [
+    azurerm_servicebus_subscription.first,
+    azurerm_servicebus_subscription.second,
+  ]
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
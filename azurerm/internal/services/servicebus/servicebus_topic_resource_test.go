@@ -3,6 +3,7 @@ package servicebus_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/servicebus/parse"
@@ -158,6 +159,18 @@ func TestAccServiceBusTopic_enablePartitioningPremium(t *testing.T) {
 	})
 }
 
+func TestAccServiceBusTopic_enablePartitioningMaxSizeExceeded(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_servicebus_topic", "test")
+	r := ServiceBusTopicResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config:      r.enablePartitioningMaxSizeExceeded(data),
+			ExpectError: regexp.MustCompile("`max_size_in_megabytes` must be at most 5120 when `enable_partitioning` is set to `true` in a Standard Namespace"),
+		},
+	})
+}
+
 func TestAccServiceBusTopic_enableDuplicateDetection(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_servicebus_topic", "test")
 	r := ServiceBusTopicResource{}
@@ -361,6 +374,34 @@ resource "azurerm_servicebus_topic" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger)
 }
 
+func (ServiceBusTopicResource) enablePartitioningMaxSizeExceeded(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_servicebus_namespace" "test" {
+  name                = "acctestservicebusnamespace-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "Standard"
+}
+
+resource "azurerm_servicebus_topic" "test" {
+  name                  = "acctestservicebustopic-%d"
+  namespace_name        = azurerm_servicebus_namespace.test.name
+  resource_group_name   = azurerm_resource_group.test.name
+  enable_partitioning   = true
+  max_size_in_megabytes = 81920
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger)
+}
+
 func (ServiceBusTopicResource) enablePartitioningPremium(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
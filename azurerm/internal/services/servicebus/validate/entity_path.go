@@ -0,0 +1,28 @@
+package validate
+
+import "fmt"
+
+// entityPathMaxLength is the maximum length of the path of a Service Bus entity, including the name of its Namespace
+const entityPathMaxLength = 260
+
+// SubscriptionEntityPath validates that the entity path of a Subscription (`{namespace}/{topic}/Subscriptions/{subscription}`)
+// doesn't exceed the maximum length supported by Service Bus, which the individual name validations can't detect
+func SubscriptionEntityPath(namespaceName, topicName, subscriptionName string) error {
+	path := fmt.Sprintf("%s/%s/Subscriptions/%s", namespaceName, topicName, subscriptionName)
+	return validateEntityPathLength(path)
+}
+
+// SubscriptionRuleEntityPath validates that the entity path of a Subscription Rule
+// (`{namespace}/{topic}/Subscriptions/{subscription}/Rules/{rule}`) doesn't exceed the maximum length supported by Service Bus
+func SubscriptionRuleEntityPath(namespaceName, topicName, subscriptionName, ruleName string) error {
+	path := fmt.Sprintf("%s/%s/Subscriptions/%s/Rules/%s", namespaceName, topicName, subscriptionName, ruleName)
+	return validateEntityPathLength(path)
+}
+
+func validateEntityPathLength(path string) error {
+	if len(path) > entityPathMaxLength {
+		return fmt.Errorf("the entity path %q is %d characters long but must be at most %d characters - use a shorter Namespace, Topic or Subscription name", path, len(path), entityPathMaxLength)
+	}
+
+	return nil
+}
@@ -0,0 +1,104 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSubscriptionEntityPath(t *testing.T) {
+	tests := []struct {
+		name         string
+		namespace    string
+		topic        string
+		subscription string
+		valid        bool
+	}{
+		{
+			name:         "Short names",
+			namespace:    "namespace1",
+			topic:        "topic1",
+			subscription: "subscription1",
+			valid:        true,
+		},
+		{
+			name:         "Path at the maximum length",
+			namespace:    "namespace1",
+			topic:        strings.Repeat("t", 184),
+			subscription: strings.Repeat("s", 50),
+			valid:        true,
+		},
+		{
+			name:         "Long Topic and Subscription names",
+			namespace:    "namespace1",
+			topic:        strings.Repeat("t", 200),
+			subscription: strings.Repeat("s", 50),
+			valid:        false,
+		},
+		{
+			name:         "Long Namespace name",
+			namespace:    strings.Repeat("n", 50),
+			topic:        strings.Repeat("t", 184),
+			subscription: strings.Repeat("s", 50),
+			valid:        false,
+		},
+		{
+			name:         "Topic name at the maximum length",
+			namespace:    "namespace1",
+			topic:        strings.Repeat("t", 260),
+			subscription: "s",
+			valid:        false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := SubscriptionEntityPath(tt.namespace, tt.topic, tt.subscription)
+			valid := err == nil
+			if valid != tt.valid {
+				t.Errorf("Expected valid status %t but got %t for topic %q and subscription %q: %+v", tt.valid, valid, tt.topic, tt.subscription, err)
+			}
+		})
+	}
+}
+
+func TestSubscriptionRuleEntityPath(t *testing.T) {
+	tests := []struct {
+		name         string
+		topic        string
+		subscription string
+		rule         string
+		valid        bool
+	}{
+		{
+			name:         "Short names",
+			topic:        "topic1",
+			subscription: "subscription1",
+			rule:         "rule1",
+			valid:        true,
+		},
+		{
+			name:         "Path at the maximum length",
+			topic:        strings.Repeat("t", 127),
+			subscription: strings.Repeat("s", 50),
+			rule:         strings.Repeat("r", 50),
+			valid:        true,
+		},
+		{
+			name:         "Long Topic, Subscription and Rule names",
+			topic:        strings.Repeat("t", 150),
+			subscription: strings.Repeat("s", 50),
+			rule:         strings.Repeat("r", 50),
+			valid:        false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := SubscriptionRuleEntityPath("namespace1", tt.topic, tt.subscription, tt.rule)
+			valid := err == nil
+			if valid != tt.valid {
+				t.Errorf("Expected valid status %t but got %t for rule path %q/%q/%q: %+v", tt.valid, valid, tt.topic, tt.subscription, tt.rule, err)
+			}
+		})
+	}
+}
@@ -25,12 +25,15 @@ import (
 
 type Client struct {
 	AccountsClient           *storage.AccountsClient
+	BlobContainersClient     *storage.BlobContainersClient
 	FileSystemsClient        *filesystems.Client
 	ADLSGen2PathsClient      *paths.Client
 	ManagementPoliciesClient *storage.ManagementPoliciesClient
 	BlobServicesClient       *storage.BlobServicesClient
 	CloudEndpointsClient     *storagesync.CloudEndpointsClient
 	EncryptionScopesClient   *storage.EncryptionScopesClient
+	FileServicesClient       *storage.FileServicesClient
+	TableServicesClient      *storage.TableServicesClient
 	Environment              az.Environment
 	SyncServiceClient        *storagesync.ServicesClient
 	SyncGroupsClient         *storagesync.SyncGroupsClient
@@ -44,6 +47,9 @@ func NewClient(options *common.ClientOptions) *Client {
 	accountsClient := storage.NewAccountsClientWithBaseURI(options.ResourceManagerEndpoint, options.SubscriptionId)
 	options.ConfigureClient(&accountsClient.Client, options.ResourceManagerAuthorizer)
 
+	blobContainersClient := storage.NewBlobContainersClientWithBaseURI(options.ResourceManagerEndpoint, options.SubscriptionId)
+	options.ConfigureClient(&blobContainersClient.Client, options.ResourceManagerAuthorizer)
+
 	fileSystemsClient := filesystems.NewWithEnvironment(options.Environment)
 	options.ConfigureClient(&fileSystemsClient.Client, options.StorageAuthorizer)
 
@@ -62,6 +68,12 @@ func NewClient(options *common.ClientOptions) *Client {
 	encryptionScopesClient := storage.NewEncryptionScopesClientWithBaseURI(options.ResourceManagerEndpoint, options.SubscriptionId)
 	options.ConfigureClient(&encryptionScopesClient.Client, options.ResourceManagerAuthorizer)
 
+	fileServicesClient := storage.NewFileServicesClientWithBaseURI(options.ResourceManagerEndpoint, options.SubscriptionId)
+	options.ConfigureClient(&fileServicesClient.Client, options.ResourceManagerAuthorizer)
+
+	tableServicesClient := storage.NewTableServicesClientWithBaseURI(options.ResourceManagerEndpoint, options.SubscriptionId)
+	options.ConfigureClient(&tableServicesClient.Client, options.ResourceManagerAuthorizer)
+
 	syncServiceClient := storagesync.NewServicesClientWithBaseURI(options.ResourceManagerEndpoint, options.SubscriptionId)
 	options.ConfigureClient(&syncServiceClient.Client, options.ResourceManagerAuthorizer)
 
@@ -72,12 +84,15 @@ func NewClient(options *common.ClientOptions) *Client {
 	// (which should fix #2977) when the storage clients have been moved in here
 	client := Client{
 		AccountsClient:           &accountsClient,
+		BlobContainersClient:     &blobContainersClient,
 		FileSystemsClient:        &fileSystemsClient,
 		ADLSGen2PathsClient:      &adlsGen2PathsClient,
 		ManagementPoliciesClient: &managementPoliciesClient,
 		BlobServicesClient:       &blobServicesClient,
 		CloudEndpointsClient:     &cloudEndpointsClient,
 		EncryptionScopesClient:   &encryptionScopesClient,
+		FileServicesClient:       &fileServicesClient,
+		TableServicesClient:      &tableServicesClient,
 		Environment:              options.Environment,
 		SubscriptionId:           options.SubscriptionId,
 		SyncServiceClient:        &syncServiceClient,
@@ -72,3 +72,94 @@ func schemaStorageAccountCorsRule(patchEnabled bool) *schema.Schema {
 		},
 	}
 }
+
+func schemaStorageAccountQueueProperties() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"cors_rule": schemaStorageAccountCorsRule(false),
+		"logging": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"version": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+					"delete": {
+						Type:     schema.TypeBool,
+						Required: true,
+					},
+					"read": {
+						Type:     schema.TypeBool,
+						Required: true,
+					},
+					"write": {
+						Type:     schema.TypeBool,
+						Required: true,
+					},
+					"retention_policy_days": {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IntBetween(1, 365),
+					},
+				},
+			},
+		},
+		"hour_metrics": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"version": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+					"enabled": {
+						Type:     schema.TypeBool,
+						Required: true,
+					},
+					"include_apis": {
+						Type:     schema.TypeBool,
+						Optional: true,
+					},
+					"retention_policy_days": {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IntBetween(1, 365),
+					},
+				},
+			},
+		},
+		"minute_metrics": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"version": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+					"enabled": {
+						Type:     schema.TypeBool,
+						Required: true,
+					},
+					"include_apis": {
+						Type:     schema.TypeBool,
+						Optional: true,
+					},
+					"retention_policy_days": {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IntBetween(1, 365),
+					},
+				},
+			},
+		},
+	}
+}
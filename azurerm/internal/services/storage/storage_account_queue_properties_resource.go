@@ -0,0 +1,265 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2019-06-01/storage"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/client"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/shim"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/validate"
+	azSchema "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/tombuildsstuff/giovanni/storage/2019-12-12/queue/queues"
+)
+
+func resourceStorageAccountQueueProperties() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceStorageAccountQueuePropertiesCreateUpdate,
+		Read:   resourceStorageAccountQueuePropertiesRead,
+		Update: resourceStorageAccountQueuePropertiesCreateUpdate,
+		Delete: resourceStorageAccountQueuePropertiesDelete,
+
+		Importer: azSchema.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := parse.StorageAccountID(id)
+			return err
+		}),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: azure.MergeSchema(map[string]*schema.Schema{
+			"storage_account_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.StorageAccountID,
+			},
+		}, schemaStorageAccountQueuePropertiesResource()),
+	}
+}
+
+// schemaStorageAccountQueuePropertiesResource returns the Queue Properties schema, where the `logging`, `hour_metrics`
+// and `minute_metrics` blocks are Computed since the Queue Service always returns them
+func schemaStorageAccountQueuePropertiesResource() map[string]*schema.Schema {
+	s := schemaStorageAccountQueueProperties()
+	for _, key := range []string{"logging", "hour_metrics", "minute_metrics"} {
+		s[key].Computed = true
+	}
+
+	return s
+}
+
+func resourceStorageAccountQueuePropertiesCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	storageClient := meta.(*clients.Client).Storage
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.StorageAccountID(d.Get("storage_account_id").(string))
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.Name, storageAccountResourceName)
+	defer locks.UnlockByName(id.Name, storageAccountResourceName)
+
+	account, err := storageClient.AccountsClient.GetProperties(ctx, id.ResourceGroup, id.Name, "")
+	if err != nil {
+		return fmt.Errorf("retrieving Storage Account %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	if err := validateStorageAccountSupportsQueueProperties(account); err != nil {
+		return fmt.Errorf("Storage Account %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	properties, err := expandQueueProperties([]interface{}{
+		map[string]interface{}{
+			"cors_rule":      d.Get("cors_rule"),
+			"logging":        d.Get("logging"),
+			"hour_metrics":   d.Get("hour_metrics"),
+			"minute_metrics": d.Get("minute_metrics"),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("expanding Queue Properties for Storage Account %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	// the `logging`, `hour_metrics` and `minute_metrics` blocks are Computed, so when one of these is omitted the
+	// existing settings are retained rather than being reset to their defaults
+	existing, err := getStorageAccountQueueProperties(ctx, storageClient, *id)
+	if err != nil {
+		return err
+	}
+	if len(d.Get("logging").([]interface{})) == 0 {
+		properties.Logging = existing.Logging
+	}
+	if len(d.Get("hour_metrics").([]interface{})) == 0 {
+		properties.HourMetrics = existing.HourMetrics
+	}
+	if len(d.Get("minute_metrics").([]interface{})) == 0 {
+		properties.MinuteMetrics = existing.MinuteMetrics
+	}
+
+	if err := updateStorageAccountQueueProperties(ctx, storageClient, *id, properties); err != nil {
+		return err
+	}
+
+	d.SetId(id.ID())
+
+	return resourceStorageAccountQueuePropertiesRead(d, meta)
+}
+
+func resourceStorageAccountQueuePropertiesRead(d *schema.ResourceData, meta interface{}) error {
+	storageClient := meta.(*clients.Client).Storage
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.StorageAccountID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	account, err := storageClient.FindAccount(ctx, id.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving Storage Account %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+	if account == nil {
+		log.Printf("[DEBUG] Storage Account %q (Resource Group %q) was not found - removing Queue Properties from state", id.Name, id.ResourceGroup)
+		d.SetId("")
+		return nil
+	}
+
+	queueClient, err := storageClient.QueuesClient(ctx, *account)
+	if err != nil {
+		return fmt.Errorf("building Queues Client: %+v", err)
+	}
+
+	props, err := queueClient.GetServiceProperties(ctx, account.ResourceGroup, id.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving Queue Properties for Storage Account %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	d.Set("storage_account_id", id.ID())
+
+	queueProperties := map[string]interface{}{}
+	if flattened := flattenQueueProperties(props); len(flattened) > 0 {
+		queueProperties = flattened[0].(map[string]interface{})
+	}
+
+	for _, key := range []string{"cors_rule", "logging", "hour_metrics", "minute_metrics"} {
+		value, ok := queueProperties[key]
+		if !ok {
+			value = []interface{}{}
+		}
+
+		if err := d.Set(key, value); err != nil {
+			return fmt.Errorf("setting `%s`: %+v", key, err)
+		}
+	}
+
+	return nil
+}
+
+func resourceStorageAccountQueuePropertiesDelete(d *schema.ResourceData, meta interface{}) error {
+	storageClient := meta.(*clients.Client).Storage
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.StorageAccountID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.Name, storageAccountResourceName)
+	defer locks.UnlockByName(id.Name, storageAccountResourceName)
+
+	// the Queue Properties can't be removed, so instead we reset them to their defaults
+	properties := queues.StorageServiceProperties{
+		Cors: &queues.Cors{
+			CorsRule: []queues.CorsRule{},
+		},
+		Logging: &queues.LoggingConfig{
+			Version: "1.0",
+		},
+		HourMetrics: &queues.MetricsConfig{
+			Version: "1.0",
+		},
+		MinuteMetrics: &queues.MetricsConfig{
+			Version: "1.0",
+		},
+	}
+
+	return updateStorageAccountQueueProperties(ctx, storageClient, *id, properties)
+}
+
+func getStorageAccountQueueProperties(ctx context.Context, storageClient *client.Client, id parse.StorageAccountId) (*queues.StorageServiceProperties, error) {
+	queueClient, resourceGroup, err := buildStorageAccountQueuesClient(ctx, storageClient, id)
+	if err != nil {
+		return nil, err
+	}
+
+	props, err := queueClient.GetServiceProperties(ctx, resourceGroup, id.Name)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving Queue Properties for Storage Account %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+	if props == nil {
+		return nil, fmt.Errorf("retrieving Queue Properties for Storage Account %q (Resource Group %q): `properties` was nil", id.Name, id.ResourceGroup)
+	}
+
+	return props, nil
+}
+
+func updateStorageAccountQueueProperties(ctx context.Context, storageClient *client.Client, id parse.StorageAccountId, properties queues.StorageServiceProperties) error {
+	queueClient, resourceGroup, err := buildStorageAccountQueuesClient(ctx, storageClient, id)
+	if err != nil {
+		return err
+	}
+
+	if err := queueClient.UpdateServiceProperties(ctx, resourceGroup, id.Name, properties); err != nil {
+		return fmt.Errorf("updating Queue Properties for Storage Account %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	return nil
+}
+
+func buildStorageAccountQueuesClient(ctx context.Context, storageClient *client.Client, id parse.StorageAccountId) (shim.StorageQueuesWrapper, string, error) {
+	account, err := storageClient.FindAccount(ctx, id.Name)
+	if err != nil {
+		return nil, "", fmt.Errorf("retrieving Storage Account %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+	if account == nil {
+		return nil, "", fmt.Errorf("unable to locate Storage Account %q (Resource Group %q)", id.Name, id.ResourceGroup)
+	}
+
+	queueClient, err := storageClient.QueuesClient(ctx, *account)
+	if err != nil {
+		return nil, "", fmt.Errorf("building Queues Client: %+v", err)
+	}
+
+	return queueClient, account.ResourceGroup, nil
+}
+
+// validateStorageAccountSupportsQueueProperties validates that the Storage Account supports the Queue Service - which
+// is only available for Standard `Storage` and `StorageV2` accounts
+func validateStorageAccountSupportsQueueProperties(account storage.Account) error {
+	if account.Sku == nil || account.Sku.Tier != storage.Standard {
+		return fmt.Errorf("Queue Properties are only supported for Standard Storage Accounts")
+	}
+
+	if account.Kind != storage.Storage && account.Kind != storage.StorageV2 {
+		return fmt.Errorf("Queue Properties are only supported for Storage Accounts of kind %q or %q but got %q", string(storage.Storage), string(storage.StorageV2), string(account.Kind))
+	}
+
+	return nil
+}
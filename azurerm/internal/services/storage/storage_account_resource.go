@@ -1,6 +1,7 @@
 package storage
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net/http"
@@ -13,6 +14,7 @@ import (
 	autorestAzure "github.com/Azure/go-autorest/autorest/azure"
 	"github.com/hashicorp/go-azure-helpers/response"
 	"github.com/hashicorp/go-getter/helper/url"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
@@ -217,6 +219,38 @@ func resourceStorageAccount() *schema.Resource {
 				},
 			},
 
+			"routing": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"choice": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  string(storage.MicrosoftRouting),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(storage.InternetRouting),
+								string(storage.MicrosoftRouting),
+							}, false),
+						},
+
+						"publish_internet_endpoints": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+
+						"publish_microsoft_endpoints": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+
 			"identity": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -267,98 +301,28 @@ func resourceStorageAccount() *schema.Resource {
 								},
 							},
 						},
-					},
-				},
-			},
 
-			"queue_properties": {
-				Type:     schema.TypeList,
-				Optional: true,
-				Computed: true,
-				MaxItems: 1,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"cors_rule": schemaStorageAccountCorsRule(false),
-						"logging": {
-							Type:     schema.TypeList,
+						"versioning_enabled": {
+							Type:     schema.TypeBool,
 							Optional: true,
-							MaxItems: 1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"version": {
-										Type:         schema.TypeString,
-										Required:     true,
-										ValidateFunc: validation.StringIsNotEmpty,
-									},
-									"delete": {
-										Type:     schema.TypeBool,
-										Required: true,
-									},
-									"read": {
-										Type:     schema.TypeBool,
-										Required: true,
-									},
-									"write": {
-										Type:     schema.TypeBool,
-										Required: true,
-									},
-									"retention_policy_days": {
-										Type:         schema.TypeInt,
-										Optional:     true,
-										ValidateFunc: validation.IntBetween(1, 365),
-									},
-								},
-							},
+							Default:  false,
 						},
-						"hour_metrics": {
-							Type:     schema.TypeList,
+
+						"change_feed_enabled": {
+							Type:     schema.TypeBool,
 							Optional: true,
-							MaxItems: 1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"version": {
-										Type:         schema.TypeString,
-										Required:     true,
-										ValidateFunc: validation.StringIsNotEmpty,
-									},
-									"enabled": {
-										Type:     schema.TypeBool,
-										Required: true,
-									},
-									"include_apis": {
-										Type:     schema.TypeBool,
-										Optional: true,
-									},
-									"retention_policy_days": {
-										Type:         schema.TypeInt,
-										Optional:     true,
-										ValidateFunc: validation.IntBetween(1, 365),
-									},
-								},
-							},
+							Default:  false,
 						},
-						"minute_metrics": {
+
+						"restore_policy": {
 							Type:     schema.TypeList,
 							Optional: true,
 							MaxItems: 1,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
-									"version": {
-										Type:         schema.TypeString,
-										Required:     true,
-										ValidateFunc: validation.StringIsNotEmpty,
-									},
-									"enabled": {
-										Type:     schema.TypeBool,
-										Required: true,
-									},
-									"include_apis": {
-										Type:     schema.TypeBool,
-										Optional: true,
-									},
-									"retention_policy_days": {
+									"days": {
 										Type:         schema.TypeInt,
-										Optional:     true,
+										Required:     true,
 										ValidateFunc: validation.IntBetween(1, 365),
 									},
 								},
@@ -368,6 +332,40 @@ func resourceStorageAccount() *schema.Resource {
 				},
 			},
 
+			"queue_properties": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: schemaStorageAccountQueueProperties(),
+				},
+			},
+
+			"share_properties": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cors_rule": schemaStorageAccountCorsRule(false),
+					},
+				},
+			},
+
+			"table_properties": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cors_rule": schemaStorageAccountCorsRule(false),
+					},
+				},
+			},
+
 			"static_website": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -524,6 +522,206 @@ func resourceStorageAccount() *schema.Resource {
 				Computed: true,
 			},
 
+			"primary_blob_microsoft_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"primary_blob_microsoft_host": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"primary_dfs_microsoft_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"primary_dfs_microsoft_host": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"primary_file_microsoft_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"primary_file_microsoft_host": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"primary_queue_microsoft_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"primary_queue_microsoft_host": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"primary_table_microsoft_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"primary_table_microsoft_host": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"primary_web_microsoft_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"primary_web_microsoft_host": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"primary_blob_internet_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"primary_blob_internet_host": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"primary_dfs_internet_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"primary_dfs_internet_host": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"primary_file_internet_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"primary_file_internet_host": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"primary_web_internet_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"primary_web_internet_host": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"secondary_blob_microsoft_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"secondary_blob_microsoft_host": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"secondary_dfs_microsoft_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"secondary_dfs_microsoft_host": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"secondary_file_microsoft_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"secondary_file_microsoft_host": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"secondary_queue_microsoft_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"secondary_queue_microsoft_host": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"secondary_table_microsoft_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"secondary_table_microsoft_host": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"secondary_web_microsoft_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"secondary_web_microsoft_host": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"secondary_blob_internet_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"secondary_blob_internet_host": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"secondary_dfs_internet_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"secondary_dfs_internet_host": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"secondary_file_internet_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"secondary_file_internet_host": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"secondary_web_internet_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"secondary_web_internet_host": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
 			"primary_access_key": {
 				Type:      schema.TypeString,
 				Sensitive: true,
@@ -588,6 +786,12 @@ func resourceStorageAccount() *schema.Resource {
 				}
 			}
 
+			if d.NewValueKnown("blob_properties") {
+				if err := validateBlobPropertiesRestorePolicy(d.Get("blob_properties").([]interface{})); err != nil {
+					return err
+				}
+			}
+
 			return nil
 		},
 	}
@@ -665,6 +869,10 @@ func resourceStorageAccountCreate(d *schema.ResourceData, meta interface{}) erro
 		},
 	}
 
+	if _, ok := d.GetOk("routing"); ok {
+		parameters.AccountPropertiesCreateParameters.RoutingPreference = expandStorageAccountRouting(d.Get("routing").([]interface{}))
+	}
+
 	// For all Clouds except Public and USGovernmentCloud, don't specify "allow_blob_public_access" and "min_tls_version" in request body.
 	// https://github.com/terraform-providers/terraform-provider-azurerm/issues/7812
 	// https://github.com/terraform-providers/terraform-provider-azurerm/issues/8083
@@ -785,6 +993,27 @@ func resourceStorageAccountCreate(d *schema.ResourceData, meta interface{}) erro
 		}
 	}
 
+	if val, ok := d.GetOk("share_properties"); ok {
+		if !storageAccountSupportsShareProperties(accountKind, accountTier) {
+			return fmt.Errorf("`share_properties` are only supported for `FileStorage` accounts and Standard `Storage` and `StorageV2` accounts.")
+		}
+
+		if err := updateStorageAccountShareProperties(ctx, meta.(*clients.Client).Storage.FileServicesClient, resourceGroupName, storageAccountName, val.([]interface{})); err != nil {
+			return err
+		}
+	}
+
+	if val, ok := d.GetOk("table_properties"); ok {
+		if !storageAccountSupportsTableProperties(accountKind, accountTier) {
+			return fmt.Errorf("`table_properties` are only supported for Standard `Storage` and `StorageV2` accounts.")
+		}
+
+		tableClient := meta.(*clients.Client).Storage.TableServicesClient
+		if _, err := tableClient.SetServiceProperties(ctx, resourceGroupName, storageAccountName, expandTableProperties(val.([]interface{}))); err != nil {
+			return fmt.Errorf("updating `table_properties` for Storage Account %q (Resource Group %q): %+v", storageAccountName, resourceGroupName, err)
+		}
+	}
+
 	if val, ok := d.GetOk("static_website"); ok {
 		// static website only supported on StorageV2 and BlockBlobStorage
 		if accountKind != string(storage.StorageV2) && accountKind != string(storage.BlockBlobStorage) {
@@ -810,6 +1039,10 @@ func resourceStorageAccountCreate(d *schema.ResourceData, meta interface{}) erro
 		if _, err = accountsClient.SetServiceProperties(ctx, storageAccountName, staticWebsiteProps); err != nil {
 			return fmt.Errorf("Error updating Azure Storage Account `static_website` %q: %+v", storageAccountName, err)
 		}
+
+		if err := waitForStorageAccountStaticWebsiteToBeUpdated(ctx, accountsClient, storageAccountName, staticWebsiteProps, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return err
+		}
 	}
 
 	return resourceStorageAccountRead(d, meta)
@@ -988,6 +1221,18 @@ func resourceStorageAccountUpdate(d *schema.ResourceData, meta interface{}) erro
 		}
 	}
 
+	if d.HasChange("routing") {
+		opts := storage.AccountUpdateParameters{
+			AccountPropertiesUpdateParameters: &storage.AccountPropertiesUpdateParameters{
+				RoutingPreference: expandStorageAccountRouting(d.Get("routing").([]interface{})),
+			},
+		}
+
+		if _, err := client.Update(ctx, resourceGroupName, storageAccountName, opts); err != nil {
+			return fmt.Errorf("Error updating Azure Storage Account routing %q: %+v", storageAccountName, err)
+		}
+	}
+
 	if d.HasChange("large_file_share_enabled") {
 		isEnabled := storage.LargeFileSharesStateDisabled
 		if v := d.Get("large_file_share_enabled").(bool); v {
@@ -1043,6 +1288,27 @@ func resourceStorageAccountUpdate(d *schema.ResourceData, meta interface{}) erro
 		}
 	}
 
+	if d.HasChange("share_properties") {
+		if !storageAccountSupportsShareProperties(accountKind, accountTier) {
+			return fmt.Errorf("`share_properties` are only supported for `FileStorage` accounts and Standard `Storage` and `StorageV2` accounts.")
+		}
+
+		if err := updateStorageAccountShareProperties(ctx, meta.(*clients.Client).Storage.FileServicesClient, resourceGroupName, storageAccountName, d.Get("share_properties").([]interface{})); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("table_properties") {
+		if !storageAccountSupportsTableProperties(accountKind, accountTier) {
+			return fmt.Errorf("`table_properties` are only supported for Standard `Storage` and `StorageV2` accounts.")
+		}
+
+		tableClient := meta.(*clients.Client).Storage.TableServicesClient
+		if _, err := tableClient.SetServiceProperties(ctx, resourceGroupName, storageAccountName, expandTableProperties(d.Get("table_properties").([]interface{}))); err != nil {
+			return fmt.Errorf("updating `table_properties` for Storage Account %q (Resource Group %q): %+v", storageAccountName, resourceGroupName, err)
+		}
+	}
+
 	if d.HasChange("static_website") {
 		// static website only supported on StorageV2 and BlockBlobStorage
 		if accountKind != string(storage.StorageV2) && accountKind != string(storage.BlockBlobStorage) {
@@ -1068,6 +1334,10 @@ func resourceStorageAccountUpdate(d *schema.ResourceData, meta interface{}) erro
 		if _, err = accountsClient.SetServiceProperties(ctx, storageAccountName, staticWebsiteProps); err != nil {
 			return fmt.Errorf("Error updating Azure Storage Account `static_website` %q: %+v", storageAccountName, err)
 		}
+
+		if err := waitForStorageAccountStaticWebsiteToBeUpdated(ctx, accountsClient, storageAccountName, staticWebsiteProps, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
 	}
 
 	return resourceStorageAccountRead(d, meta)
@@ -1160,6 +1430,10 @@ func resourceStorageAccountRead(d *schema.ResourceData, meta interface{}) error
 			}
 		}
 
+		if err := d.Set("routing", flattenStorageAccountRouting(props.RoutingPreference)); err != nil {
+			return fmt.Errorf("Error setting `routing`: %+v", err)
+		}
+
 		// Computed
 		d.Set("primary_location", props.PrimaryLocation)
 		d.Set("secondary_location", props.SecondaryLocation)
@@ -1271,6 +1545,28 @@ func resourceStorageAccountRead(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
+	if storageAccountSupportsShareProperties(string(resp.Kind), string(resp.Sku.Tier)) {
+		shareProps, err := storageClient.FileServicesClient.GetServiceProperties(ctx, resGroup, name)
+		if err != nil {
+			return fmt.Errorf("reading share properties for Storage Account %q (Resource Group %q): %+v", name, resGroup, err)
+		}
+
+		if err := d.Set("share_properties", flattenShareProperties(shareProps)); err != nil {
+			return fmt.Errorf("setting `share_properties`: %+v", err)
+		}
+	}
+
+	if storageAccountSupportsTableProperties(string(resp.Kind), string(resp.Sku.Tier)) {
+		tableProps, err := storageClient.TableServicesClient.GetServiceProperties(ctx, resGroup, name)
+		if err != nil {
+			return fmt.Errorf("reading table properties for Storage Account %q (Resource Group %q): %+v", name, resGroup, err)
+		}
+
+		if err := d.Set("table_properties", flattenTableProperties(tableProps)); err != nil {
+			return fmt.Errorf("setting `table_properties`: %+v", err)
+		}
+	}
+
 	var staticWebsite []interface{}
 
 	// static website only supported on StorageV2 and BlockBlobStorage
@@ -1389,6 +1685,47 @@ func expandStorageAccountCustomDomain(d *schema.ResourceData) *storage.CustomDom
 	}
 }
 
+func expandStorageAccountRouting(input []interface{}) *storage.RoutingPreference {
+	if len(input) == 0 || input[0] == nil {
+		return &storage.RoutingPreference{
+			RoutingChoice:             storage.MicrosoftRouting,
+			PublishInternetEndpoints:  utils.Bool(false),
+			PublishMicrosoftEndpoints: utils.Bool(false),
+		}
+	}
+
+	routing := input[0].(map[string]interface{})
+	return &storage.RoutingPreference{
+		RoutingChoice:             storage.RoutingChoice(routing["choice"].(string)),
+		PublishInternetEndpoints:  utils.Bool(routing["publish_internet_endpoints"].(bool)),
+		PublishMicrosoftEndpoints: utils.Bool(routing["publish_microsoft_endpoints"].(bool)),
+	}
+}
+
+func flattenStorageAccountRouting(input *storage.RoutingPreference) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	publishInternetEndpoints := false
+	if input.PublishInternetEndpoints != nil {
+		publishInternetEndpoints = *input.PublishInternetEndpoints
+	}
+
+	publishMicrosoftEndpoints := false
+	if input.PublishMicrosoftEndpoints != nil {
+		publishMicrosoftEndpoints = *input.PublishMicrosoftEndpoints
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"choice":                      string(input.RoutingChoice),
+			"publish_internet_endpoints":  publishInternetEndpoints,
+			"publish_microsoft_endpoints": publishMicrosoftEndpoints,
+		},
+	}
+}
+
 func flattenStorageAccountCustomDomain(input *storage.CustomDomain) []interface{} {
 	domain := make(map[string]interface{})
 
@@ -1488,9 +1825,69 @@ func expandBlobProperties(input []interface{}) storage.BlobServiceProperties {
 	corsRaw := v["cors_rule"].([]interface{})
 	props.BlobServicePropertiesProperties.Cors = expandBlobPropertiesCors(corsRaw)
 
+	props.BlobServicePropertiesProperties.IsVersioningEnabled = utils.Bool(v["versioning_enabled"].(bool))
+
+	props.BlobServicePropertiesProperties.ChangeFeed = &storage.ChangeFeed{
+		Enabled: utils.Bool(v["change_feed_enabled"].(bool)),
+	}
+
+	restorePolicyRaw := v["restore_policy"].([]interface{})
+	props.BlobServicePropertiesProperties.RestorePolicy = expandBlobPropertiesRestorePolicy(restorePolicyRaw)
+
 	return props
 }
 
+func expandBlobPropertiesRestorePolicy(input []interface{}) *storage.RestorePolicyProperties {
+	restorePolicy := storage.RestorePolicyProperties{
+		Enabled: utils.Bool(false),
+	}
+
+	if len(input) == 0 || input[0] == nil {
+		return &restorePolicy
+	}
+
+	policy := input[0].(map[string]interface{})
+	restorePolicy.Enabled = utils.Bool(true)
+	restorePolicy.Days = utils.Int32(int32(policy["days"].(int)))
+
+	return &restorePolicy
+}
+
+// validateBlobPropertiesRestorePolicy ensures the prerequisites for Point-in-Time Restore are met, since the
+// API only surfaces these once the Storage Account exists
+func validateBlobPropertiesRestorePolicy(input []interface{}) error {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	restorePolicies := v["restore_policy"].([]interface{})
+	if len(restorePolicies) == 0 || restorePolicies[0] == nil {
+		return nil
+	}
+
+	if !v["versioning_enabled"].(bool) {
+		return fmt.Errorf("`blob_properties.0.versioning_enabled` must be `true` when `blob_properties.0.restore_policy` is specified")
+	}
+
+	if !v["change_feed_enabled"].(bool) {
+		return fmt.Errorf("`blob_properties.0.change_feed_enabled` must be `true` when `blob_properties.0.restore_policy` is specified")
+	}
+
+	deletePolicies := v["delete_retention_policy"].([]interface{})
+	if len(deletePolicies) == 0 || deletePolicies[0] == nil {
+		return fmt.Errorf("`blob_properties.0.delete_retention_policy` must be specified when `blob_properties.0.restore_policy` is specified")
+	}
+
+	restoreDays := restorePolicies[0].(map[string]interface{})["days"].(int)
+	deleteDays := deletePolicies[0].(map[string]interface{})["days"].(int)
+	if restoreDays >= deleteDays {
+		return fmt.Errorf("`blob_properties.0.restore_policy.0.days` (%d) must be less than `blob_properties.0.delete_retention_policy.0.days` (%d)", restoreDays, deleteDays)
+	}
+
+	return nil
+}
+
 func expandBlobPropertiesDeleteRetentionPolicy(input []interface{}) *storage.DeleteRetentionPolicy {
 	deleteRetentionPolicy := storage.DeleteRetentionPolicy{
 		Enabled: utils.Bool(false),
@@ -1574,8 +1971,11 @@ func expandQueueProperties(input []interface{}) (queues.StorageServiceProperties
 }
 
 func expandQueuePropertiesMetrics(input []interface{}) (*queues.MetricsConfig, error) {
+	// when the block has been removed the metrics are disabled, which still requires a version
 	if len(input) == 0 {
-		return &queues.MetricsConfig{}, nil
+		return &queues.MetricsConfig{
+			Version: "1.0",
+		}, nil
 	}
 
 	metricsAttr := input[0].(map[string]interface{})
@@ -1607,8 +2007,11 @@ func expandQueuePropertiesMetrics(input []interface{}) (*queues.MetricsConfig, e
 }
 
 func expandQueuePropertiesLogging(input []interface{}) *queues.LoggingConfig {
+	// when the block has been removed logging is disabled, which still requires a version
 	if len(input) == 0 {
-		return &queues.LoggingConfig{}
+		return &queues.LoggingConfig{
+			Version: "1.0",
+		}
 	}
 
 	loggingAttr := input[0].(map[string]interface{})
@@ -1751,7 +2154,19 @@ func flattenBlobProperties(input storage.BlobServiceProperties) []interface{} {
 		flattenedDeletePolicy = flattenBlobPropertiesDeleteRetentionPolicy(deletePolicy)
 	}
 
-	if len(flattenedCorsRules) == 0 && len(flattenedDeletePolicy) == 0 {
+	versioningEnabled := false
+	if input.BlobServicePropertiesProperties.IsVersioningEnabled != nil {
+		versioningEnabled = *input.BlobServicePropertiesProperties.IsVersioningEnabled
+	}
+
+	changeFeedEnabled := false
+	if changeFeed := input.BlobServicePropertiesProperties.ChangeFeed; changeFeed != nil && changeFeed.Enabled != nil {
+		changeFeedEnabled = *changeFeed.Enabled
+	}
+
+	flattenedRestorePolicy := flattenBlobPropertiesRestorePolicy(input.BlobServicePropertiesProperties.RestorePolicy)
+
+	if len(flattenedCorsRules) == 0 && len(flattenedDeletePolicy) == 0 && !versioningEnabled && !changeFeedEnabled && len(flattenedRestorePolicy) == 0 {
 		return []interface{}{}
 	}
 
@@ -1759,6 +2174,124 @@ func flattenBlobProperties(input storage.BlobServiceProperties) []interface{} {
 		map[string]interface{}{
 			"cors_rule":               flattenedCorsRules,
 			"delete_retention_policy": flattenedDeletePolicy,
+			"versioning_enabled":      versioningEnabled,
+			"change_feed_enabled":     changeFeedEnabled,
+			"restore_policy":          flattenedRestorePolicy,
+		},
+	}
+}
+
+func flattenBlobPropertiesRestorePolicy(input *storage.RestorePolicyProperties) []interface{} {
+	restorePolicy := make([]interface{}, 0)
+
+	if input == nil {
+		return restorePolicy
+	}
+
+	if enabled := input.Enabled; enabled != nil && *enabled {
+		days := 0
+		if input.Days != nil {
+			days = int(*input.Days)
+		}
+
+		restorePolicy = append(restorePolicy, map[string]interface{}{
+			"days": days,
+		})
+	}
+
+	return restorePolicy
+}
+
+// storageAccountSupportsShareProperties returns whether the File Service of a Storage Account can be configured - which
+// is only available for Standard `Storage` and `StorageV2` accounts and `FileStorage` accounts
+func storageAccountSupportsShareProperties(accountKind string, accountTier string) bool {
+	if accountKind == string(storage.FileStorage) {
+		return true
+	}
+
+	return accountTier == string(storage.Standard) && (accountKind == string(storage.Storage) || accountKind == string(storage.StorageV2))
+}
+
+// storageAccountSupportsTableProperties returns whether the Table Service of a Storage Account can be configured - which
+// is only available for Standard `Storage` and `StorageV2` accounts
+func storageAccountSupportsTableProperties(accountKind string, accountTier string) bool {
+	return accountTier == string(storage.Standard) && (accountKind == string(storage.Storage) || accountKind == string(storage.StorageV2))
+}
+
+func updateStorageAccountShareProperties(ctx context.Context, client *storage.FileServicesClient, resourceGroup string, accountName string, input []interface{}) error {
+	// the File Service also holds the Share Delete Retention Policy, which isn't managed here - so we retrieve
+	// the existing properties to ensure that it's not reset when the CORS rules are updated
+	props, err := client.GetServiceProperties(ctx, resourceGroup, accountName)
+	if err != nil {
+		return fmt.Errorf("retrieving `share_properties` for Storage Account %q (Resource Group %q): %+v", accountName, resourceGroup, err)
+	}
+
+	params := storage.FileServiceProperties{
+		FileServicePropertiesProperties: &storage.FileServicePropertiesProperties{},
+	}
+	if props.FileServicePropertiesProperties != nil {
+		params.FileServicePropertiesProperties.ShareDeleteRetentionPolicy = props.FileServicePropertiesProperties.ShareDeleteRetentionPolicy
+	}
+	params.FileServicePropertiesProperties.Cors = expandServicePropertiesCors(input)
+
+	if _, err := client.SetServiceProperties(ctx, resourceGroup, accountName, params); err != nil {
+		return fmt.Errorf("updating `share_properties` for Storage Account %q (Resource Group %q): %+v", accountName, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func expandTableProperties(input []interface{}) storage.TableServiceProperties {
+	return storage.TableServiceProperties{
+		TableServicePropertiesProperties: &storage.TableServicePropertiesProperties{
+			Cors: expandServicePropertiesCors(input),
+		},
+	}
+}
+
+// expandServicePropertiesCors expands the `cors_rule` of a `share_properties` or `table_properties` block, which
+// both use the same CORS Rules as the Blob Service
+func expandServicePropertiesCors(input []interface{}) *storage.CorsRules {
+	if len(input) == 0 || input[0] == nil {
+		return &storage.CorsRules{
+			CorsRules: &[]storage.CorsRule{},
+		}
+	}
+
+	v := input[0].(map[string]interface{})
+	corsRules := expandBlobPropertiesCors(v["cors_rule"].([]interface{}))
+	if corsRules.CorsRules == nil {
+		corsRules.CorsRules = &[]storage.CorsRule{}
+	}
+
+	return corsRules
+}
+
+func flattenShareProperties(input storage.FileServiceProperties) []interface{} {
+	if input.FileServicePropertiesProperties == nil {
+		return []interface{}{}
+	}
+
+	return flattenServicePropertiesCors(input.FileServicePropertiesProperties.Cors)
+}
+
+func flattenTableProperties(input storage.TableServiceProperties) []interface{} {
+	if input.TableServicePropertiesProperties == nil {
+		return []interface{}{}
+	}
+
+	return flattenServicePropertiesCors(input.TableServicePropertiesProperties.Cors)
+}
+
+func flattenServicePropertiesCors(input *storage.CorsRules) []interface{} {
+	corsRules := flattenBlobPropertiesCorsRule(input)
+	if len(corsRules) == 0 {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"cors_rule": corsRules,
 		},
 	}
 }
@@ -1929,6 +2462,55 @@ func flattenCorsProperty(input string) []interface{} {
 	return results
 }
 
+// waitForStorageAccountStaticWebsiteToBeUpdated waits for the Static Website configuration to be returned, since the
+// Blob Service Properties are eventually consistent - which would otherwise lead to the subsequent read retrieving
+// (and storing) the previous Static Website configuration
+func waitForStorageAccountStaticWebsiteToBeUpdated(ctx context.Context, client *accounts.Client, accountName string, expected accounts.StorageServiceProperties, timeout time.Duration) error {
+	log.Printf("[DEBUG] Waiting for the `static_website` configuration of Storage Account %q to be updated..", accountName)
+	stateConf := &resource.StateChangeConf{
+		Pending:                   []string{"Pending"},
+		Target:                    []string{"Updated"},
+		Refresh:                   storageAccountStaticWebsiteRefreshFunc(ctx, client, accountName, expected),
+		MinTimeout:                5 * time.Second,
+		ContinuousTargetOccurence: 3,
+		Timeout:                   timeout,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("waiting for the `static_website` configuration of Storage Account %q to be updated: %+v", accountName, err)
+	}
+
+	return nil
+}
+
+func storageAccountStaticWebsiteRefreshFunc(ctx context.Context, client *accounts.Client, accountName string, expected accounts.StorageServiceProperties) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := client.GetServiceProperties(ctx, accountName)
+		if err != nil {
+			return nil, "", fmt.Errorf("retrieving the Blob Service Properties for Storage Account %q: %+v", accountName, err)
+		}
+
+		// the service omits the Static Website configuration when it's not enabled
+		actual := accounts.StaticWebsite{}
+		if props := resp.StorageServiceProperties; props != nil && props.StaticWebsite != nil {
+			actual = *props.StaticWebsite
+		}
+		desired := accounts.StaticWebsite{}
+		if expected.StaticWebsite != nil {
+			desired = *expected.StaticWebsite
+		}
+
+		if actual.Enabled != desired.Enabled {
+			return resp, "Pending", nil
+		}
+		// when disabled the documents aren't relevant (and may not be cleared by the service)
+		if actual.Enabled && (actual.IndexDocument != desired.IndexDocument || actual.ErrorDocument404Path != desired.ErrorDocument404Path) {
+			return resp, "Pending", nil
+		}
+
+		return resp, "Updated", nil
+	}
+}
+
 func flattenStaticWebsiteProperties(input accounts.GetServicePropertiesResult) []interface{} {
 	if storageServiceProps := input.StorageServiceProperties; storageServiceProps != nil {
 		if staticWebsite := storageServiceProps.StaticWebsite; staticWebsite != nil {
@@ -2039,12 +2621,12 @@ func flattenAndSetAzureRmStorageAccountPrimaryEndpoints(d *schema.ResourceData,
 		return err
 	}
 
-	return nil
+	return flattenAndSetAzureRmStorageAccountRoutingEndpoints(d, "primary", primary.MicrosoftEndpoints, primary.InternetEndpoints)
 }
 
 func flattenAndSetAzureRmStorageAccountSecondaryEndpoints(d *schema.ResourceData, secondary *storage.Endpoints) error {
 	if secondary == nil {
-		return nil
+		return flattenAndSetAzureRmStorageAccountRoutingEndpoints(d, "secondary", nil, nil)
 	}
 
 	if err := setEndpointAndHost(d, "secondary", secondary.Blob, "blob"); err != nil {
@@ -2065,6 +2647,50 @@ func flattenAndSetAzureRmStorageAccountSecondaryEndpoints(d *schema.ResourceData
 	if err := setEndpointAndHost(d, "secondary", secondary.Web, "web"); err != nil {
 		return err
 	}
+	return flattenAndSetAzureRmStorageAccountRoutingEndpoints(d, "secondary", secondary.MicrosoftEndpoints, secondary.InternetEndpoints)
+}
+
+func flattenAndSetAzureRmStorageAccountRoutingEndpoints(d *schema.ResourceData, ordinalString string, microsoft *storage.AccountMicrosoftEndpoints, internet *storage.AccountInternetEndpoints) error {
+	// these are only returned when publishing of the corresponding endpoints is enabled within the `routing` block
+	if microsoft == nil {
+		microsoft = &storage.AccountMicrosoftEndpoints{}
+	}
+	if internet == nil {
+		internet = &storage.AccountInternetEndpoints{}
+	}
+
+	if err := setEndpointAndHost(d, ordinalString, microsoft.Blob, "blob_microsoft"); err != nil {
+		return err
+	}
+	if err := setEndpointAndHost(d, ordinalString, microsoft.Dfs, "dfs_microsoft"); err != nil {
+		return err
+	}
+	if err := setEndpointAndHost(d, ordinalString, microsoft.File, "file_microsoft"); err != nil {
+		return err
+	}
+	if err := setEndpointAndHost(d, ordinalString, microsoft.Queue, "queue_microsoft"); err != nil {
+		return err
+	}
+	if err := setEndpointAndHost(d, ordinalString, microsoft.Table, "table_microsoft"); err != nil {
+		return err
+	}
+	if err := setEndpointAndHost(d, ordinalString, microsoft.Web, "web_microsoft"); err != nil {
+		return err
+	}
+
+	if err := setEndpointAndHost(d, ordinalString, internet.Blob, "blob_internet"); err != nil {
+		return err
+	}
+	if err := setEndpointAndHost(d, ordinalString, internet.Dfs, "dfs_internet"); err != nil {
+		return err
+	}
+	if err := setEndpointAndHost(d, ordinalString, internet.File, "file_internet"); err != nil {
+		return err
+	}
+	if err := setEndpointAndHost(d, ordinalString, internet.Web, "web_internet"); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -0,0 +1,55 @@
+package storage
+
+import (
+	"testing"
+
+	"github.com/tombuildsstuff/giovanni/storage/2019-12-12/queue/queues"
+)
+
+func TestExpandQueuePropertiesLoggingEmpty(t *testing.T) {
+	// a removed `logging` block disables logging, which still requires a version
+	actual := expandQueuePropertiesLogging([]interface{}{})
+	expected := queues.LoggingConfig{
+		Version: "1.0",
+	}
+
+	if actual == nil || *actual != expected {
+		t.Fatalf("expected %+v but got %+v", expected, actual)
+	}
+}
+
+func TestExpandQueuePropertiesMetricsEmpty(t *testing.T) {
+	// a removed `hour_metrics` / `minute_metrics` block disables the metrics, which still requires a version
+	actual, err := expandQueuePropertiesMetrics([]interface{}{})
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+
+	if actual == nil || actual.Version != "1.0" || actual.Enabled || actual.IncludeAPIs != nil || actual.RetentionPolicy.Enabled {
+		t.Fatalf("expected disabled metrics with version `1.0` but got %+v", actual)
+	}
+}
+
+func TestExpandQueuePropertiesRemovedBlocks(t *testing.T) {
+	actual, err := expandQueueProperties([]interface{}{
+		map[string]interface{}{
+			"cors_rule":      []interface{}{},
+			"logging":        []interface{}{},
+			"hour_metrics":   []interface{}{},
+			"minute_metrics": []interface{}{},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+
+	if actual.Logging == nil || actual.Logging.Version != "1.0" {
+		t.Fatalf("expected `logging` to be disabled with version `1.0` but got %+v", actual.Logging)
+	}
+	if actual.HourMetrics == nil || actual.HourMetrics.Version != "1.0" || actual.HourMetrics.Enabled {
+		t.Fatalf("expected `hour_metrics` to be disabled with version `1.0` but got %+v", actual.HourMetrics)
+	}
+	if actual.MinuteMetrics == nil || actual.MinuteMetrics.Version != "1.0" || actual.MinuteMetrics.Enabled {
+		t.Fatalf("expected `minute_metrics` to be disabled with version `1.0` but got %+v", actual.MinuteMetrics)
+	}
+}
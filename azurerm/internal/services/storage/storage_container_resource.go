@@ -1,10 +1,12 @@
 package storage
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2019-06-01/storage"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
@@ -12,6 +14,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/parse"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 	"github.com/tombuildsstuff/giovanni/storage/2019-12-12/blob/containers"
 )
 
@@ -61,6 +64,22 @@ func resourceStorageContainer() *schema.Resource {
 				}, false),
 			},
 
+			"default_encryption_scope": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.StorageEncryptionScopeName,
+			},
+
+			"encryption_scope_override_enabled": {
+				Type:         schema.TypeBool,
+				Optional:     true,
+				Default:      true,
+				ForceNew:     true,
+				RequiredWith: []string{"default_encryption_scope"},
+			},
+
 			"metadata": MetaDataComputedSchema(),
 
 			// TODO: support for ACL's, Legal Holds and Immutability Policies
@@ -108,6 +127,10 @@ func resourceStorageContainerCreate(d *schema.ResourceData, meta interface{}) er
 		return fmt.Errorf("building storage client: %+v", err)
 	}
 
+	if err := validateStorageContainerAccessTypeIsAllowed(ctx, storageClient.AccountsClient, account.ResourceGroup, accountName, accessLevelRaw); err != nil {
+		return err
+	}
+
 	id := parse.NewStorageContainerDataPlaneId(accountName, storageClient.Environment.StorageEndpointSuffix, containerName).ID()
 	exists, err := client.Exists(ctx, account.ResourceGroup, accountName, containerName)
 	if err != nil {
@@ -118,13 +141,37 @@ func resourceStorageContainerCreate(d *schema.ResourceData, meta interface{}) er
 	}
 
 	log.Printf("[INFO] Creating Container %q in Storage Account %q", containerName, accountName)
-	input := containers.CreateInput{
-		AccessLevel: accessLevel,
-		MetaData:    metaData,
-	}
+	if encryptionScope := d.Get("default_encryption_scope").(string); encryptionScope != "" {
+		// the Data Plane API doesn't support Encryption Scopes, so these Containers have to be created via Resource Manager
+		encryptionScopesClient := storageClient.EncryptionScopesClient
+		scope, err := encryptionScopesClient.Get(ctx, account.ResourceGroup, accountName, encryptionScope)
+		if err != nil {
+			if utils.ResponseWasNotFound(scope.Response) {
+				return fmt.Errorf("Encryption Scope %q was not found in Storage Account %q (Resource Group %q)", encryptionScope, accountName, account.ResourceGroup)
+			}
+			return fmt.Errorf("retrieving Encryption Scope %q (Storage Account %q / Resource Group %q): %+v", encryptionScope, accountName, account.ResourceGroup, err)
+		}
+
+		input := storage.BlobContainer{
+			ContainerProperties: &storage.ContainerProperties{
+				PublicAccess:                expandStorageContainerPublicAccess(accessLevelRaw),
+				Metadata:                    utils.ExpandMapStringPtrString(metaDataRaw),
+				DefaultEncryptionScope:      utils.String(encryptionScope),
+				DenyEncryptionScopeOverride: utils.Bool(!d.Get("encryption_scope_override_enabled").(bool)),
+			},
+		}
+		if _, err := storageClient.BlobContainersClient.Create(ctx, account.ResourceGroup, accountName, containerName, input); err != nil {
+			return fmt.Errorf("failed creating container: %+v", err)
+		}
+	} else {
+		input := containers.CreateInput{
+			AccessLevel: accessLevel,
+			MetaData:    metaData,
+		}
 
-	if err := client.Create(ctx, account.ResourceGroup, accountName, containerName, input); err != nil {
-		return fmt.Errorf("failed creating container: %+v", err)
+		if err := client.Create(ctx, account.ResourceGroup, accountName, containerName, input); err != nil {
+			return fmt.Errorf("failed creating container: %+v", err)
+		}
 	}
 
 	d.SetId(id)
@@ -158,6 +205,10 @@ func resourceStorageContainerUpdate(d *schema.ResourceData, meta interface{}) er
 		accessLevelRaw := d.Get("container_access_type").(string)
 		accessLevel := expandStorageContainerAccessLevel(accessLevelRaw)
 
+		if err := validateStorageContainerAccessTypeIsAllowed(ctx, storageClient.AccountsClient, account.ResourceGroup, id.AccountName, accessLevelRaw); err != nil {
+			return err
+		}
+
 		if err := client.UpdateAccessLevel(ctx, account.ResourceGroup, id.AccountName, id.Name, accessLevel); err != nil {
 			return fmt.Errorf("updating the Access Control for Container %q (Storage Account %q / Resource Group %q): %s", id.Name, id.AccountName, account.ResourceGroup, err)
 		}
@@ -227,6 +278,28 @@ func resourceStorageContainerRead(d *schema.ResourceData, meta interface{}) erro
 	d.Set("has_immutability_policy", props.HasImmutabilityPolicy)
 	d.Set("has_legal_hold", props.HasLegalHold)
 
+	// the Encryption Scope properties are only exposed via the Resource Manager API - which the credentials in use may
+	// not have access to, so a 403 is only a hard requirement when an Encryption Scope has been configured
+	defaultEncryptionScope := ""
+	encryptionScopeOverrideEnabled := true
+	container, err := storageClient.BlobContainersClient.Get(ctx, account.ResourceGroup, id.AccountName, id.Name)
+	if err != nil {
+		if !utils.ResponseWasForbidden(container.Response) || d.Get("default_encryption_scope").(string) != "" {
+			return fmt.Errorf("retrieving Resource Manager properties for Container %q (Account %q / Resource Group %q): %s", id.Name, id.AccountName, account.ResourceGroup, err)
+		}
+
+		log.Printf("[WARN] Unable to retrieve the Resource Manager properties for Container %q (Account %q / Resource Group %q) - assuming no Encryption Scope is configured: %s", id.Name, id.AccountName, account.ResourceGroup, err)
+	} else if props := container.ContainerProperties; props != nil {
+		if props.DefaultEncryptionScope != nil {
+			defaultEncryptionScope = *props.DefaultEncryptionScope
+		}
+		if props.DenyEncryptionScopeOverride != nil {
+			encryptionScopeOverrideEnabled = !*props.DenyEncryptionScopeOverride
+		}
+	}
+	d.Set("default_encryption_scope", defaultEncryptionScope)
+	d.Set("encryption_scope_override_enabled", encryptionScopeOverrideEnabled)
+
 	resourceManagerId := parse.NewStorageContainerResourceManagerID(subscriptionId, account.ResourceGroup, id.AccountName, "default", id.Name)
 	d.Set("resource_manager_id", resourceManagerId.ID())
 
@@ -262,6 +335,27 @@ func resourceStorageContainerDelete(d *schema.ResourceData, meta interface{}) er
 	return nil
 }
 
+// validateStorageContainerAccessTypeIsAllowed returns an error when public access is requested for a Container within
+// a Storage Account which disallows public access. This is checked at apply-time rather than plan-time since
+// `allow_blob_public_access` may be enabled on the Storage Account within the same apply.
+func validateStorageContainerAccessTypeIsAllowed(ctx context.Context, client *storage.AccountsClient, resourceGroup, accountName, accessType string) error {
+	if accessType == "private" {
+		return nil
+	}
+
+	// the Storage Account is retrieved rather than using the cached details, since these may be outdated
+	resp, err := client.GetProperties(ctx, resourceGroup, accountName, "")
+	if err != nil {
+		return fmt.Errorf("retrieving Storage Account %q (Resource Group %q): %+v", accountName, resourceGroup, err)
+	}
+
+	if props := resp.AccountProperties; props != nil && props.AllowBlobPublicAccess != nil && !*props.AllowBlobPublicAccess {
+		return fmt.Errorf("`container_access_type` cannot be set to %q since public access is disallowed for Storage Account %q (Resource Group %q) - either set `allow_blob_public_access` to `true` on the Storage Account or set `container_access_type` to `private`", accessType, accountName, resourceGroup)
+	}
+
+	return nil
+}
+
 func expandStorageContainerAccessLevel(input string) containers.AccessLevel {
 	// for historical reasons, "private" above is an empty string in the API
 	// so the enum doesn't 1:1 match. You could argue the SDK should handle this
@@ -273,6 +367,18 @@ func expandStorageContainerAccessLevel(input string) containers.AccessLevel {
 	return containers.AccessLevel(input)
 }
 
+func expandStorageContainerPublicAccess(input string) storage.PublicAccess {
+	// the Resource Manager API uses a different casing for the Access Level, and "None" rather than "private"
+	switch input {
+	case string(containers.Blob):
+		return storage.PublicAccessBlob
+	case string(containers.Container):
+		return storage.PublicAccessContainer
+	}
+
+	return storage.PublicAccessNone
+}
+
 func flattenStorageContainerAccessLevel(input containers.AccessLevel) string {
 	// for historical reasons, "private" above is an empty string in the API
 	if input == containers.Private {